@@ -9,19 +9,25 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	scalargo "github.com/bdpiprava/scalar-go"
-	"github.com/joho/godotenv"
 
 	"go-api-template/database"
 	"go-api-template/internal/auth"
+	"go-api-template/internal/i18n"
+	"go-api-template/internal/privacy"
 	"go-api-template/internal/users"
 	"go-api-template/pkg/config"
+	"go-api-template/pkg/diagnostics"
+	"go-api-template/pkg/featureflags"
 	"go-api-template/pkg/middleware"
 	"go-api-template/pkg/response"
+	"go-api-template/pkg/router"
 
 	_ "go-api-template/docs"
 )
@@ -48,8 +54,9 @@ import (
 //	@produce	json
 
 func main() {
-	// Load .env file if it exists (ignore error if not found)
-	_ = godotenv.Load() //nolint:errcheck // .env file is optional
+	// Load .env file if it exists; the real environment always takes precedence
+	dotEnv := config.NewDotEnv(".env")
+	_ = dotEnv.Load() //nolint:errcheck // .env file is optional
 
 	// Load configuration
 	cfg := config.Load()
@@ -57,25 +64,58 @@ func main() {
 	// Setup structured logger
 	logger := setupLogger(cfg)
 
-	// Connect to database
-	if err := database.Connect(); err != nil {
+	// Parse feature flags (an invalid spec is a deployment mistake, so fail fast)
+	flags, err := featureflags.Parse(cfg.FeatureFlags)
+	if err != nil {
+		logger.Error("invalid feature flags", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	featureFlags.Store(flags)
+
+	// Connect to database (an unreachable database starts the server in degraded mode)
+	db, err := database.Connect(context.Background(), cfg.Database)
+	if err != nil {
 		logger.Error("database connection failed", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	if !db.Ready() {
+		logger.Error("database unreachable, starting in degraded mode")
+	}
+	if db.HasReplica() {
+		logger.Info("read replica configured")
+	}
+	db.StartMonitor(logger, cfg.Database.HealthCheckInterval, cfg.Database.ReconnectMaxBackoff)
 	defer func() {
-		if err := database.Close(); err != nil {
+		if err := db.Close(); err != nil {
 			logger.Error("database close failed", slog.String("error", err.Error()))
 		}
 	}()
 
 	// Create HTTP router
-	mux := http.NewServeMux()
+	rt := router.New(http.NewServeMux(), cfg.Server.DevRoutesEnabled)
 
 	// Register routes
-	registerRoutes(mux, cfg)
+	if err := registerRoutes(rt, db, cfg); err != nil {
+		logger.Error("route registration failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	// Create the rate limiter up front so config reloads can change its limits
+	var limiter *middleware.RateLimiter
+	if cfg.RateLimit.Enabled {
+		limiter = middleware.NewRateLimiter(middleware.RateLimitConfig{
+			Rate:            cfg.RateLimit.Rate,
+			Window:          cfg.RateLimit.Window,
+			CleanupInterval: 5 * time.Minute,
+		})
+		defer limiter.Stop()
+	}
 
 	// Setup middleware chain
-	handler := setupMiddleware(mux, logger, cfg)
+	handler := setupMiddleware(rt, logger, cfg, limiter)
+
+	// Reload runtime-adjustable settings on SIGHUP
+	go watchConfigReload(cfg, dotEnv, logger, limiter)
 
 	// Create HTTP server with production-ready timeouts
 	server := &http.Server{
@@ -100,18 +140,48 @@ func main() {
 		}
 	}()
 
+	// Start internal diagnostics server (pprof, expvar) if configured
+	debugServer := startDebugServer(cfg, logger)
+
 	// Wait for interrupt signal for graceful shutdown
-	gracefulShutdown(server, logger, cfg.Server.ShutdownTimeout)
+	gracefulShutdown(server, debugServer, db, logger, cfg.Server.ShutdownTimeout)
+}
+
+// startDebugServer starts the internal-only diagnostics server if DEBUG_ADDR is set.
+// Returns nil when diagnostics are disabled.
+func startDebugServer(cfg *config.Config, logger *slog.Logger) *http.Server {
+	if cfg.Server.DebugAddr == "" {
+		return nil
+	}
+
+	// No WriteTimeout: CPU profiles and execution traces stream for the requested duration
+	server := &http.Server{
+		Addr:              cfg.Server.DebugAddr,
+		Handler:           diagnostics.NewHandler(&featureFlags),
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+	}
+
+	go func() {
+		logger.Info("🔧 Diagnostics ready", slog.String("addr", cfg.Server.DebugAddr))
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("diagnostics server failed", slog.String("error", err.Error()))
+		}
+	}()
+
+	return server
 }
 
 // customTextHandler creates a cleaner text handler for development
 type customTextHandler struct {
 	w     io.Writer
-	level slog.Level
+	level slog.Leveler
 }
 
 func (h *customTextHandler) Enabled(_ context.Context, level slog.Level) bool {
-	return level >= h.level
+	return level >= h.level.Level()
 }
 
 func (h *customTextHandler) Handle(_ context.Context, r slog.Record) error {
@@ -158,27 +228,37 @@ func (h *customTextHandler) WithGroup(name string) slog.Handler {
 	return h
 }
 
-// setupLogger creates a structured logger based on configuration
-func setupLogger(cfg *config.Config) *slog.Logger {
-	var handler slog.Handler
+// logLevel is the minimum log level, shared by all handlers so it can change on config reload
+var logLevel = new(slog.LevelVar)
+
+// featureFlags is the active flag set, replaced on config reload
+var featureFlags atomic.Pointer[featureflags.Flags]
 
-	// Set log level
-	var level slog.Level
-	switch cfg.Log.Level {
+// parseLogLevel converts a LOG_LEVEL value to a slog level (default: info)
+func parseLogLevel(level string) slog.Level {
+	switch level {
 	case "debug":
-		level = slog.LevelDebug
+		return slog.LevelDebug
 	case "warn":
-		level = slog.LevelWarn
+		return slog.LevelWarn
 	case "error":
-		level = slog.LevelError
+		return slog.LevelError
 	default:
-		level = slog.LevelInfo
+		return slog.LevelInfo
 	}
+}
+
+// setupLogger creates a structured logger based on configuration
+func setupLogger(cfg *config.Config) *slog.Logger {
+	var handler slog.Handler
+
+	// Set log level (adjustable at runtime through logLevel)
+	logLevel.Set(parseLogLevel(cfg.Log.Level))
 
 	// Set log format
 	if cfg.Log.Format == "json" || cfg.IsProduction() {
 		opts := &slog.HandlerOptions{
-			Level:     level,
+			Level:     logLevel,
 			AddSource: cfg.Log.AddSource,
 		}
 		handler = slog.NewJSONHandler(os.Stdout, opts)
@@ -186,7 +266,7 @@ func setupLogger(cfg *config.Config) *slog.Logger {
 		// Use custom text handler for cleaner development logs
 		handler = &customTextHandler{
 			w:     os.Stdout,
-			level: level,
+			level: logLevel,
 		}
 	}
 
@@ -197,7 +277,7 @@ func setupLogger(cfg *config.Config) *slog.Logger {
 }
 
 // setupMiddleware chains all middleware in the correct order
-func setupMiddleware(handler http.Handler, logger *slog.Logger, cfg *config.Config) http.Handler {
+func setupMiddleware(handler http.Handler, logger *slog.Logger, cfg *config.Config, limiter *middleware.RateLimiter) http.Handler {
 	// Build middleware chain (order matters - first is outermost)
 	middlewares := []func(http.Handler) http.Handler{
 		middleware.Recovery(logger),                         // Recover from panics first
@@ -212,28 +292,24 @@ func setupMiddleware(handler http.Handler, logger *slog.Logger, cfg *config.Conf
 	}
 
 	// Add rate limiting if enabled
-	if cfg.RateLimit.Enabled {
-		middlewares = append(middlewares, middleware.RateLimit(middleware.RateLimitConfig{
-			Rate:            cfg.RateLimit.Rate,
-			Window:          cfg.RateLimit.Window,
-			CleanupInterval: 5 * time.Minute,
-		}))
+	if limiter != nil {
+		middlewares = append(middlewares, middleware.RateLimitWith(limiter, nil))
 	}
 
 	return middleware.Chain(handler, middlewares...)
 }
 
 // registerRoutes registers all application routes
-func registerRoutes(mux *http.ServeMux, cfg *config.Config) {
-	// Health check endpoint (checks database connectivity)
-	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+func registerRoutes(rt *router.Router, db *database.DB, cfg *config.Config) error {
+	// Health check endpoint (checks database and read replica connectivity)
+	rt.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
 		health := map[string]any{
 			"status":    "healthy",
 			"timestamp": time.Now().UTC().Format(time.RFC3339),
 		}
 
 		// Check database health
-		if err := database.Health(r.Context()); err != nil {
+		if err := db.Health(r.Context()); err != nil {
 			health["status"] = "unhealthy"
 			health["database"] = map[string]string{
 				"status": "unhealthy",
@@ -244,17 +320,31 @@ func registerRoutes(mux *http.ServeMux, cfg *config.Config) {
 		}
 
 		health["database"] = map[string]string{"status": "healthy"}
+
+		// A failing replica degrades listings but does not make the service unhealthy
+		if db.HasReplica() {
+			replica := map[string]string{"status": "healthy"}
+			if err := db.ReplicaHealth(r.Context()); err != nil {
+				health["status"] = "degraded"
+				replica = map[string]string{
+					"status": "unhealthy",
+					"error":  err.Error(),
+				}
+			}
+			health["replica"] = replica
+		}
+
 		response.Success(w, health)
 	})
 
 	// Liveness probe (simple check - server is running)
-	mux.HandleFunc("GET /health/live", func(w http.ResponseWriter, _ *http.Request) {
+	rt.HandleFunc("GET /health/live", func(w http.ResponseWriter, _ *http.Request) {
 		response.Success(w, map[string]string{"status": "alive"})
 	})
 
 	// Readiness probe (checks if ready to accept traffic)
-	mux.HandleFunc("GET /health/ready", func(w http.ResponseWriter, r *http.Request) {
-		if err := database.Health(r.Context()); err != nil {
+	rt.HandleFunc("GET /health/ready", func(w http.ResponseWriter, _ *http.Request) {
+		if !db.Ready() {
 			response.Error(w, http.StatusServiceUnavailable, "Not ready")
 			return
 		}
@@ -262,13 +352,13 @@ func registerRoutes(mux *http.ServeMux, cfg *config.Config) {
 	})
 
 	// Serve swagger.json directly
-	mux.HandleFunc("GET /docs/swagger.json", func(w http.ResponseWriter, r *http.Request) {
+	rt.HandleFunc("GET /docs/swagger.json", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		http.ServeFile(w, r, "./docs/swagger.json")
 	})
 
 	// API documentation with Scalar
-	mux.HandleFunc("GET /docs", func(w http.ResponseWriter, _ *http.Request) {
+	rt.HandleFunc("GET /docs", func(w http.ResponseWriter, _ *http.Request) {
 		html, err := scalargo.NewV2(
 			scalargo.WithSpecDir("./docs"),
 			scalargo.WithBaseFileName("openapi.json"),
@@ -298,15 +388,75 @@ func registerRoutes(mux *http.ServeMux, cfg *config.Config) {
 		fmt.Fprint(w, html)
 	})
 
+	// Example endpoint (development only, not mounted in production unless DEV_ROUTES_ENABLED=true)
+	rt.HandleDevFunc("GET /test/hello", func(w http.ResponseWriter, _ *http.Request) {
+		response.Success(w, map[string]string{"message": "Hello, World!"})
+	})
+
 	// Register auth routes (returns jwtService for protecting other routes)
-	jwtService := auth.RegisterRoutes(mux, database.DB, cfg)
+	jwtService, err := auth.RegisterRoutes(rt.Mux(), db, cfg)
+	if err != nil {
+		return err
+	}
 
 	// Register feature routes (protected with auth)
-	users.RegisterRoutes(mux, database.DB, jwtService)
+	users.RegisterRoutes(rt.Mux(), db, jwtService, cfg)
+	privacy.RegisterRoutes(rt.Mux(), db, jwtService, cfg)
+
+	// Register translation bundle routes (public)
+	return i18n.RegisterRoutes(rt.Mux())
+}
+
+// watchConfigReload reloads the configuration on SIGHUP. The log level, feature flags and
+// rate limits are applied immediately; any other changed keys are logged as requiring a restart.
+func watchConfigReload(cfg *config.Config, dotEnv *config.DotEnv, logger *slog.Logger, limiter *middleware.RateLimiter) {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	for range reload {
+		// Re-read .env; edited and removed keys apply, the real environment still wins
+		if err := dotEnv.Load(); err != nil {
+			logger.Error("config reload failed", slog.String("error", err.Error()))
+			continue
+		}
+
+		next := config.Load()
+		changed := config.Diff(cfg, next)
+		cfg = next
+
+		logLevel.Set(parseLogLevel(cfg.Log.Level))
+		if slices.Contains(changed, "FeatureFlags") {
+			// An invalid spec keeps the previous flags instead of taking the server down
+			flags, err := featureflags.Parse(cfg.FeatureFlags)
+			if err != nil {
+				logger.Error("invalid feature flags, keeping previous flags", slog.String("error", err.Error()))
+				changed = slices.DeleteFunc(changed, func(key string) bool { return key == "FeatureFlags" })
+			} else {
+				featureFlags.Store(flags)
+			}
+		}
+		if limiter != nil {
+			limiter.SetLimits(cfg.RateLimit.Rate, cfg.RateLimit.Window)
+		}
+
+		var applied, restartRequired []string
+		for _, key := range changed {
+			if config.IsReloadable(key) {
+				applied = append(applied, key)
+			} else {
+				restartRequired = append(restartRequired, key)
+			}
+		}
+
+		logger.Info("🔄 Config reloaded", slog.Any("applied", applied))
+		if len(restartRequired) > 0 {
+			logger.Warn("config changes require a restart", slog.Any("keys", restartRequired))
+		}
+	}
 }
 
 // gracefulShutdown handles graceful server shutdown on interrupt signals
-func gracefulShutdown(server *http.Server, logger *slog.Logger, timeout time.Duration) {
+func gracefulShutdown(server, debugServer *http.Server, db *database.DB, logger *slog.Logger, timeout time.Duration) {
 	// Create channel to listen for signals
 	quit := make(chan os.Signal, 1)
 
@@ -326,11 +476,18 @@ func gracefulShutdown(server *http.Server, logger *slog.Logger, timeout time.Dur
 		shutdownErr = err
 	}
 
+	// Stop diagnostics server (not critical, open profiles are simply dropped)
+	if debugServer != nil {
+		if err := debugServer.Shutdown(ctx); err != nil {
+			logger.Error("diagnostics shutdown error", slog.String("error", err.Error()))
+		}
+	}
+
 	// Cancel context after shutdown attempt
 	cancel()
 
-	// Close database connection
-	if err := database.Close(); err != nil {
+	// Close database connections
+	if err := db.Close(); err != nil {
 		logger.Error("database close error", slog.String("error", err.Error()))
 	}
 
@@ -3,73 +3,120 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"expvar"
 	"fmt"
-	"os"
+	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/lib/pq"
+
+	"go-api-template/pkg/config"
 )
 
-// DB is the global database connection instance
-var DB *sql.DB
+// minReconnectBackoff is the first retry delay after a failed health check
+const minReconnectBackoff = time.Second
 
-// Connect establishes a connection to the PostgreSQL database
-func Connect() error {
-	var connStr string
+// Connection metrics, exposed at /debug/vars on the diagnostics server
+var (
+	readyMetric          = expvar.NewInt("database_ready")
+	connectionLostMetric = expvar.NewInt("database_connection_lost_total")
+)
 
-	// Check if DATABASE_URL is set (used in production)
-	databaseURL := os.Getenv("DATABASE_URL")
-	if databaseURL != "" {
-		// Use DATABASE_URL directly (production mode)
-		connStr = databaseURL
-	} else {
-		// Build connection string from individual env vars (local development)
-		host := os.Getenv("DB_HOST")
-		port := os.Getenv("DB_PORT")
-		user := os.Getenv("DB_USER")
-		password := os.Getenv("DB_PASSWORD")
-		dbname := os.Getenv("DB_NAME")
-		sslmode := os.Getenv("DB_SSLMODE")
-
-		if host == "" {
-			host = "localhost"
-		}
-		if port == "" {
-			port = "5433"
-		}
-		if sslmode == "" {
-			sslmode = "disable"
-		}
+// DB holds the primary (read-write) connection pool and an optional read replica pool.
+// Pass it to features explicitly; use Writer for writes and read-after-write queries,
+// and Reader for heavy read-only queries that tolerate replication lag.
+type DB struct {
+	writer *sql.DB
+	reader *sql.DB
 
-		connStr = fmt.Sprintf(
-			"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-			host, port, user, password, dbname, sslmode,
-		)
+	ready atomic.Bool
+
+	// after schedules the monitor's next check; tests replace time.After
+	after func(time.Duration) <-chan time.Time
+
+	stopMonitor context.CancelFunc
+	monitorDone sync.WaitGroup
+}
+
+// Connect opens the primary connection and, if configured, the read replica connection.
+// An unreachable database is not an error: the pools connect lazily, Ready reports false
+// and StartMonitor keeps retrying in the background.
+func Connect(ctx context.Context, cfg config.DatabaseConfig) (*DB, error) {
+	writer, err := open(cfg.DSN(), cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &DB{writer: writer, reader: writer, after: time.After}
+
+	if cfg.ReplicaURL != "" {
+		reader, err := open(cfg.ReplicaURL, cfg)
+		if err != nil {
+			writer.Close() //nolint:errcheck // Already returning the replica error
+			return nil, fmt.Errorf("read replica: %w", err)
+		}
+		db.reader = reader
 	}
 
-	var err error
-	DB, err = sql.Open("postgres", connStr)
+	db.setReady(db.Health(ctx) == nil)
+
+	return db, nil
+}
+
+// open opens a connection pool with the configured limits
+func open(dsn string, cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
-		return fmt.Errorf("error opening database: %w", err)
+		return nil, fmt.Errorf("error opening database: %w", err)
 	}
 
 	// Configure connection pool
-	DB.SetMaxOpenConns(25)
-	DB.SetMaxIdleConns(5)
-	DB.SetConnMaxLifetime(5 * time.Minute)
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
-	// Test connection
-	if err = DB.Ping(); err != nil {
-		return fmt.Errorf("error connecting to database: %w", err)
-	}
+	return db, nil
+}
 
-	return nil
+// Writer returns the primary connection pool
+func (db *DB) Writer() *sql.DB {
+	return db.writer
+}
+
+// Reader returns the read replica connection pool, or the primary if no replica is configured
+func (db *DB) Reader() *sql.DB {
+	return db.reader
+}
+
+// HasReplica reports whether a separate read replica is configured
+func (db *DB) HasReplica() bool {
+	return db.reader != db.writer
+}
+
+// Ready reports whether the last health check succeeded
+func (db *DB) Ready() bool {
+	return db.ready.Load()
+}
+
+// setReady updates the readiness flag and metric, returning the previous value
+func (db *DB) setReady(ready bool) bool {
+	if ready {
+		readyMetric.Set(1)
+	} else {
+		readyMetric.Set(0)
+	}
+	return db.ready.Swap(ready)
 }
 
-// Health checks database connectivity with a timeout
-// Returns nil if healthy, error otherwise
-func Health(ctx context.Context) error {
-	if DB == nil {
+// Health checks primary database connectivity with a timeout
+// Returns nil if healthy, error otherwise. The read replica is checked
+// separately by ReplicaHealth so a lagging or failed replica never
+// takes the instance out of rotation.
+func (db *DB) Health(ctx context.Context) error {
+	if db == nil || db.writer == nil {
 		return fmt.Errorf("database connection not initialized")
 	}
 
@@ -77,17 +124,115 @@ func Health(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
-	if err := DB.PingContext(ctx); err != nil {
+	if err := db.writer.PingContext(ctx); err != nil {
 		return fmt.Errorf("database ping failed: %w", err)
 	}
 
 	return nil
 }
 
-// Close closes the database connection
-func Close() error {
-	if DB != nil {
-		return DB.Close()
+// ReplicaHealth checks read replica connectivity with a timeout
+// Returns nil if healthy or if no replica is configured
+func (db *DB) ReplicaHealth(ctx context.Context) error {
+	if db == nil || !db.HasReplica() {
+		return nil
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	if err := db.reader.PingContext(ctx); err != nil {
+		return fmt.Errorf("read replica ping failed: %w", err)
+	}
+
 	return nil
 }
+
+// StartMonitor checks connectivity in the background until Close is called.
+// While healthy it checks every interval; after a failure it retries with
+// exponential backoff up to maxBackoff. Readiness flips and a log event is
+// emitted whenever the connection is established or lost. Durations below
+// one second are raised to one second so the loop never pings continuously.
+func (db *DB) StartMonitor(logger *slog.Logger, interval, maxBackoff time.Duration) {
+	interval = max(interval, minReconnectBackoff)
+	maxBackoff = max(maxBackoff, minReconnectBackoff)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	db.stopMonitor = cancel
+
+	db.monitorDone.Add(1)
+	go func() {
+		defer db.monitorDone.Done()
+		db.monitor(ctx, logger, interval, maxBackoff)
+	}()
+}
+
+// monitor runs the health check loop until ctx is canceled
+func (db *DB) monitor(ctx context.Context, logger *slog.Logger, interval, maxBackoff time.Duration) {
+	backoff := minReconnectBackoff
+	for {
+		wait := interval
+		if !db.Ready() {
+			wait = backoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-db.after(wait):
+		}
+
+		err := db.Health(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			if !db.setReady(true) {
+				logger.Info("database connection established")
+			}
+			backoff = minReconnectBackoff
+			continue
+		}
+
+		if db.setReady(false) {
+			connectionLostMetric.Add(1)
+			logger.Error("database connection lost, running in degraded mode",
+				slog.String("error", err.Error()),
+				slog.Duration("retry_in", backoff),
+			)
+			continue
+		}
+
+		backoff = nextBackoff(backoff, maxBackoff)
+		logger.Warn("database reconnect failed",
+			slog.String("error", err.Error()),
+			slog.Duration("retry_in", backoff),
+		)
+	}
+}
+
+// nextBackoff doubles the current backoff, capped at maxBackoff
+func nextBackoff(current, maxBackoff time.Duration) time.Duration {
+	return min(current*2, maxBackoff)
+}
+
+// Close stops the monitor and closes the database connections
+func (db *DB) Close() error {
+	if db == nil {
+		return nil
+	}
+
+	if db.stopMonitor != nil {
+		db.stopMonitor()
+		db.monitorDone.Wait()
+	}
+
+	var errs []error
+	if db.HasReplica() {
+		errs = append(errs, db.reader.Close())
+	}
+	errs = append(errs, db.writer.Close())
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNextBackoff(t *testing.T) {
+	maxBackoff := 30 * time.Second
+
+	backoff := minReconnectBackoff
+	var got []time.Duration
+	for range 7 {
+		backoff = nextBackoff(backoff, maxBackoff)
+		got = append(got, backoff)
+	}
+
+	want := []time.Duration{
+		2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second,
+		30 * time.Second, 30 * time.Second, 30 * time.Second,
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("backoff sequence = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMonitor_ClampsZeroDurations(t *testing.T) {
+	// A closed pool fails every health check without touching the network
+	writer, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	writer.Close() //nolint:errcheck // Closing an unused pool cannot fail
+
+	db := &DB{writer: writer, reader: writer}
+	db.ready.Store(true)
+
+	// Let three checks run immediately, then stop the monitor on the fourth wait
+	var waits []time.Duration
+	db.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		if len(waits) > 3 {
+			db.stopMonitor()
+			return nil
+		}
+		fired := make(chan time.Time, 1)
+		fired <- time.Time{}
+		return fired
+	}
+
+	var logs bytes.Buffer
+	db.StartMonitor(slog.New(slog.NewJSONHandler(&logs, nil)), 0, 0)
+	db.monitorDone.Wait()
+
+	// The healthy interval, the first retry and the capped backoff are all raised to a second
+	want := []time.Duration{time.Second, time.Second, time.Second, time.Second}
+	if !slices.Equal(waits, want) {
+		t.Errorf("waits = %v, want %v", waits, want)
+	}
+	if !strings.Contains(logs.String(), "database connection lost") {
+		t.Errorf("expected connection lost log, got %s", logs.String())
+	}
+	if db.Ready() {
+		t.Error("expected database to be unready")
+	}
+}
@@ -3,23 +3,28 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
-
-	"github.com/google/uuid"
+	"net/netip"
+	"strings"
 
 	"go-api-template/internal/auth/models"
 	"go-api-template/internal/auth/services"
+	"go-api-template/pkg/middleware"
+	"go-api-template/pkg/pagination"
 	"go-api-template/pkg/response"
 )
 
 // AuthHandler handles HTTP requests for authentication
 type AuthHandler struct {
-	service *services.AuthService
+	service        *services.AuthService
+	trustedProxies []netip.Prefix
 }
 
-// NewAuthHandler creates a new auth handler
-func NewAuthHandler(service *services.AuthService) *AuthHandler {
-	return &AuthHandler{service: service}
+// NewAuthHandler creates a new auth handler. Forwarded client IP headers are
+// only honored on requests arriving from one of the trusted proxies.
+func NewAuthHandler(service *services.AuthService, trustedProxies []netip.Prefix) *AuthHandler {
+	return &AuthHandler{service: service, trustedProxies: trustedProxies}
 }
 
 // Register godoc
@@ -83,7 +88,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, tokens, err := h.service.Login(r.Context(), &req)
+	user, tokens, err := h.service.Login(r.Context(), &req, clientInfo(r, h.trustedProxies))
 	if err != nil {
 		if errors.Is(err, services.ErrInvalidCredentials) {
 			response.Unauthorized(w, map[string]string{"credentials": "Invalid email or password"})
@@ -123,7 +128,7 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, tokens, err := h.service.RefreshTokens(r.Context(), req.RefreshToken)
+	user, tokens, err := h.service.RefreshTokens(r.Context(), req.RefreshToken, clientInfo(r, h.trustedProxies))
 	if err != nil {
 		switch {
 		case errors.Is(err, services.ErrInvalidToken):
@@ -158,7 +163,7 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 // @Router       /auth/me [get]
 func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context (set by auth middleware)
-	userID, ok := r.Context().Value(UserIDKey).(uuid.UUID)
+	userID, ok := middleware.UserID(r.Context())
 	if !ok {
 		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
 		return
@@ -186,19 +191,111 @@ func (h *AuthHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 // @Success      200  {object}  models.MessageResponse
 // @Failure      401  {object}  response.Response
 // @Router       /auth/logout [post]
-func (h *AuthHandler) Logout(w http.ResponseWriter, _ *http.Request) {
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	// Get user info from context (set by auth middleware)
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
+		return
+	}
+	email, _ := middleware.UserEmail(r.Context()) // empty email is fine for the audit record
+
 	// In a stateless JWT implementation, logout is handled client-side
 	// The client should discard the tokens
 	// For added security, you could implement a token blacklist
+	h.service.Logout(r.Context(), userID, email, clientInfo(r, h.trustedProxies))
+
 	response.Success(w, map[string]string{"message": "Successfully logged out"})
 }
 
-// ContextKey is a type for context keys to avoid collisions
-type ContextKey string
+// ListSecurityEvents godoc
+// @Summary      List security events
+// @Description  Get the authenticated user's auth events (logins, failed logins, token refreshes, logouts), newest first by default
+// @Tags         Auth
+// @Produce      json
+// @Security     BearerAuth
+// @Param        limit   query     int     false  "Limit (default 20, max 100)"
+// @Param        offset  query     int     false  "Offset (default 0)"
+// @Param        sort    query     string  false  "Sort field (default created_at)"  Enums(created_at)
+// @Param        order   query     string  false  "Sort order (default desc)"        Enums(asc, desc)
+// @Success      200     {object}  models.SecurityEventsResponse
+// @Failure      400     {object}  response.Response
+// @Failure      401     {object}  response.Response
+// @Failure      500     {object}  response.Response
+// @Router       /auth/security-events [get]
+func (h *AuthHandler) ListSecurityEvents(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
+		return
+	}
+
+	params, err := pagination.Parse(r.URL.Query(), pagination.Options{
+		SortFields:  services.SecurityEventSortFields,
+		DefaultSort: "created_at",
+	})
+	if err != nil {
+		response.BadRequest(w, pagination.Fields(err))
+		return
+	}
 
-const (
-	// UserIDKey is the context key for user ID
-	UserIDKey ContextKey = "user_id"
-	// UserEmailKey is the context key for user email
-	UserEmailKey ContextKey = "user_email"
-)
+	events, meta, err := h.service.ListSecurityEvents(r.Context(), userID, params)
+	if err != nil {
+		response.InternalError(w, "Failed to retrieve security events")
+		return
+	}
+
+	response.SuccessWithMeta(w, events, meta)
+}
+
+// clientInfo extracts the client IP and user agent for auth event auditing.
+// The IP is the connection's remote address; X-Forwarded-For and X-Real-IP are
+// only used when the request comes from a trusted proxy, since any client can set them.
+func clientInfo(r *http.Request, trustedProxies []netip.Prefix) models.ClientInfo {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	if isTrustedProxy(ip, trustedProxies) {
+		if forwarded := forwardedClientIP(r.Header.Get("X-Forwarded-For"), trustedProxies); forwarded != "" {
+			ip = forwarded
+		} else if xri := strings.TrimSpace(r.Header.Get("X-Real-IP")); xri != "" {
+			ip = xri
+		}
+	}
+
+	return models.ClientInfo{
+		IPAddress: ip,
+		UserAgent: r.UserAgent(),
+	}
+}
+
+// forwardedClientIP returns the right-most X-Forwarded-For entry that is not a
+// trusted proxy. Entries to its left were supplied by the client and can be forged.
+func forwardedClientIP(xff string, trustedProxies []netip.Prefix) string {
+	hops := strings.Split(xff, ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop != "" && !isTrustedProxy(hop, trustedProxies) {
+			return hop
+		}
+	}
+	return ""
+}
+
+// isTrustedProxy reports whether ip belongs to one of the trusted proxy ranges
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
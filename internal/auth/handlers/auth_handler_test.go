@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"go-api-template/internal/auth/models"
 	"go-api-template/internal/auth/services"
+	"go-api-template/pkg/middleware"
 )
 
 // Test status constants
@@ -205,7 +207,7 @@ func (h *mockHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *mockHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
-	userID, ok := r.Context().Value(UserIDKey).(uuid.UUID)
+	userID, ok := middleware.UserID(r.Context())
 	if !ok {
 		writeJSON(w, http.StatusUnauthorized, map[string]any{
 			"status": statusFail,
@@ -741,7 +743,7 @@ func TestGetProfileEndpoint(t *testing.T) {
 		handler := newMockHandler(mock)
 
 		req := httptest.NewRequest(http.MethodGet, "/auth/me", nil)
-		ctx := context.WithValue(req.Context(), UserIDKey, testUserID)
+		ctx := middleware.WithUser(req.Context(), testUserID, "test@example.com", time.Now())
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
@@ -793,7 +795,7 @@ func TestGetProfileEndpoint(t *testing.T) {
 		handler := newMockHandler(mock)
 
 		req := httptest.NewRequest(http.MethodGet, "/auth/me", nil)
-		ctx := context.WithValue(req.Context(), UserIDKey, testUserID)
+		ctx := middleware.WithUser(req.Context(), testUserID, "test@example.com", time.Now())
 		req = req.WithContext(ctx)
 		w := httptest.NewRecorder()
 
@@ -813,3 +815,44 @@ func TestGetProfileEndpoint(t *testing.T) {
 		}
 	})
 }
+
+func TestClientInfo(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		remote  string
+		trusted []netip.Prefix
+		wantIP  string
+	}{
+		{name: "remote address without port", remote: "192.0.2.1:54321", wantIP: "192.0.2.1"},
+		{name: "remote address IPv6", remote: "[2001:db8::1]:443", wantIP: "2001:db8::1"},
+		{name: "forwarded headers ignored without trusted proxies", remote: "10.0.0.1:80", headers: map[string]string{"X-Forwarded-For": "203.0.113.7", "X-Real-IP": "198.51.100.4"}, wantIP: "10.0.0.1"},
+		{name: "forwarded headers ignored from untrusted peer", remote: "192.0.2.1:80", trusted: trusted, headers: map[string]string{"X-Forwarded-For": "203.0.113.7"}, wantIP: "192.0.2.1"},
+		{name: "forwarded IP from trusted proxy", remote: "10.0.0.1:80", trusted: trusted, headers: map[string]string{"X-Forwarded-For": "203.0.113.7"}, wantIP: "203.0.113.7"},
+		{name: "skips trusted hops", remote: "10.0.0.1:80", trusted: trusted, headers: map[string]string{"X-Forwarded-For": "203.0.113.7, 10.0.0.2"}, wantIP: "203.0.113.7"},
+		{name: "ignores forged left-most entry", remote: "10.0.0.1:80", trusted: trusted, headers: map[string]string{"X-Forwarded-For": "1.2.3.4, 203.0.113.7"}, wantIP: "203.0.113.7"},
+		{name: "real IP header from trusted proxy", remote: "10.0.0.1:80", trusted: trusted, headers: map[string]string{"X-Real-IP": "198.51.100.4"}, wantIP: "198.51.100.4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			req.RemoteAddr = tt.remote
+			req.Header.Set("User-Agent", "test-agent")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			info := clientInfo(req, tt.trusted)
+
+			if info.IPAddress != tt.wantIP {
+				t.Errorf("expected IP %s, got %s", tt.wantIP, info.IPAddress)
+			}
+			if info.UserAgent != "test-agent" {
+				t.Errorf("expected user agent test-agent, got %s", info.UserAgent)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"go-api-template/pkg/pagination"
 )
 
 // RegisterRequest represents the request body for user registration
@@ -48,6 +50,18 @@ type Claims struct {
 	Type   string    `json:"type"` // "access" or "refresh"
 	Exp    int64     `json:"exp"`
 	Iat    int64     `json:"iat"`
+	// AuthTime is when the user last authenticated with credentials (Unix seconds).
+	// It is kept across refreshes so sensitive routes can require a recent login.
+	AuthTime int64 `json:"auth_time,omitempty"`
+}
+
+// AuthenticatedAt returns when the user last authenticated with credentials.
+// Tokens issued before the auth_time claim existed fall back to their issue time.
+func (c *Claims) AuthenticatedAt() time.Time {
+	if c.AuthTime == 0 {
+		return time.Unix(c.Iat, 0)
+	}
+	return time.Unix(c.AuthTime, 0)
 }
 
 // AuthResponse represents a successful authentication response (JSend format)
@@ -73,3 +87,35 @@ type MessageResponse struct {
 	Status string            `json:"status" example:"success"`
 	Data   map[string]string `json:"data"`
 }
+
+// Security event types recorded in the auth_events table
+const (
+	EventLoginSucceeded = "login_succeeded"
+	EventLoginFailed    = "login_failed"
+	EventTokenRefreshed = "token_refreshed"
+	EventLogout         = "logout"
+)
+
+// ClientInfo identifies the client that triggered an auth event
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// SecurityEvent represents an authentication security event (login, refresh, logout)
+type SecurityEvent struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID    uuid.UUID `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreatedAt time.Time `json:"created_at"`
+	EventType string    `json:"event_type" example:"login_succeeded"`
+	Email     string    `json:"email" example:"user@example.com"`
+	IPAddress string    `json:"ip_address" example:"203.0.113.42"`
+	UserAgent string    `json:"user_agent" example:"Mozilla/5.0"`
+}
+
+// SecurityEventsResponse represents a successful list of security events response (JSend format)
+type SecurityEventsResponse struct {
+	Status string          `json:"status" example:"success"`
+	Data   []SecurityEvent `json:"data"`
+	Meta   pagination.Meta `json:"meta"`
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/auth/models"
+	"go-api-template/pkg/pagination"
+)
+
+// Column sizes of the auth_events table
+const (
+	maxEmailLength     = 255
+	maxIPAddressLength = 45
+	maxUserAgentLength = 512
+)
+
+// EventRepository handles database operations for auth security events
+type EventRepository struct {
+	db     *sql.DB
+	reader *sql.DB // read replica (or primary) for event history
+}
+
+// NewEventRepository creates a new auth event repository
+func NewEventRepository(db, reader *sql.DB) *EventRepository {
+	return &EventRepository{db: db, reader: reader}
+}
+
+// Create inserts a new security event into the database
+func (r *EventRepository) Create(ctx context.Context, event *models.SecurityEvent) error {
+	query := `
+		INSERT INTO auth_events (id, user_id, event_type, email, ip_address, user_agent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	event.ID = uuid.New()
+	event.CreatedAt = time.Now().UTC()
+
+	// Values may come straight from the client (e.g. user agents, proxy headers)
+	event.Email = truncate(event.Email, maxEmailLength)
+	event.IPAddress = truncate(event.IPAddress, maxIPAddressLength)
+	event.UserAgent = truncate(event.UserAgent, maxUserAgentLength)
+
+	_, err := r.db.ExecContext(ctx, query,
+		event.ID,
+		event.UserID,
+		event.EventType,
+		event.Email,
+		event.IPAddress,
+		event.UserAgent,
+		event.CreatedAt,
+	)
+
+	return err
+}
+
+// ListByUser retrieves a user's security events by creation time with pagination,
+// fetching p.FetchLimit() rows
+func (r *EventRepository) ListByUser(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.SecurityEvent, error) {
+	direction := "DESC"
+	if p.Order == pagination.OrderAsc {
+		direction = "ASC"
+	}
+
+	// id breaks ties so pages are stable
+	query := `
+		SELECT id, user_id, event_type, email, ip_address, user_agent, created_at
+		FROM auth_events
+		WHERE user_id = $1
+		ORDER BY created_at ` + direction + `, id ` + direction + `
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.reader.QueryContext(ctx, query, userID, p.FetchLimit(), p.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck // rows.Close() error is not critical
+
+	var events []models.SecurityEvent
+	for rows.Next() {
+		var event models.SecurityEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.UserID,
+			&event.EventType,
+			&event.Email,
+			&event.IPAddress,
+			&event.UserAgent,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// truncate shortens s to at most n bytes without leaving a split multi-byte character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
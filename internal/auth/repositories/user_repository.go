@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/auth/models"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// UserRepository handles the user account queries needed for authentication
+type UserRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates a new auth user repository
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// EmailExists reports whether an active user already uses the email
+func (r *UserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1 AND deleted_at IS NULL)`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, email).Scan(&exists)
+
+	return exists, err
+}
+
+// Create inserts a new user with the given password hash
+func (r *UserRepository) Create(ctx context.Context, user *models.AuthUser, passwordHash string) error {
+	query := `
+		INSERT INTO users (id, email, name, password_hash, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at`
+
+	user.ID = uuid.New()
+	now := time.Now().UTC()
+
+	return r.db.QueryRowContext(ctx, query,
+		user.ID,
+		user.Email,
+		user.Name,
+		passwordHash,
+		now,
+		now,
+	).Scan(&user.CreatedAt, &user.UpdatedAt)
+}
+
+// GetCredentials retrieves an active user and their password hash by email
+func (r *UserRepository) GetCredentials(ctx context.Context, email string) (*models.AuthUser, string, error) {
+	query := `
+		SELECT id, email, name, password_hash, created_at, updated_at
+		FROM users
+		WHERE email = $1 AND deleted_at IS NULL`
+
+	user := &models.AuthUser{}
+	var passwordHash string
+	err := r.db.QueryRowContext(ctx, query, email).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Name,
+		&passwordHash,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrUserNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user, passwordHash, nil
+}
+
+// GetByID retrieves an active user by ID
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.AuthUser, error) {
+	query := `
+		SELECT id, email, name, created_at, updated_at
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	user := &models.AuthUser{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&user.ID,
+		&user.Email,
+		&user.Name,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -1,18 +1,26 @@
 package auth
 
 import (
-	"database/sql"
+	"fmt"
 	"net/http"
+	"net/netip"
 	"time"
 
+	"go-api-template/database"
 	"go-api-template/internal/auth/handlers"
+	"go-api-template/internal/auth/repositories"
 	"go-api-template/internal/auth/services"
 	"go-api-template/pkg/config"
 	"go-api-template/pkg/middleware"
 )
 
 // RegisterRoutes registers all auth routes
-func RegisterRoutes(mux *http.ServeMux, db *sql.DB, cfg *config.Config) *services.JWTService {
+func RegisterRoutes(mux *http.ServeMux, db *database.DB, cfg *config.Config) (*services.JWTService, error) {
+	trustedProxies, err := parseTrustedProxies(cfg.Server.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
 	// Initialize JWT service with config
 	jwtService := services.NewJWTService(
 		cfg.JWT.SecretKey,
@@ -21,10 +29,12 @@ func RegisterRoutes(mux *http.ServeMux, db *sql.DB, cfg *config.Config) *service
 	)
 
 	// Initialize auth service
-	authService := services.NewAuthService(db, jwtService)
+	userRepo := repositories.NewUserRepository(db.Writer())
+	eventRepo := repositories.NewEventRepository(db.Writer(), db.Reader())
+	authService := services.NewAuthService(userRepo, jwtService, eventRepo)
 
 	// Initialize handler
-	handler := handlers.NewAuthHandler(authService)
+	handler := handlers.NewAuthHandler(authService, trustedProxies)
 
 	// Public routes (no auth required)
 	mux.HandleFunc("POST /auth/register", handler.Register)
@@ -34,6 +44,25 @@ func RegisterRoutes(mux *http.ServeMux, db *sql.DB, cfg *config.Config) *service
 	// Protected routes (auth required)
 	mux.HandleFunc("GET /auth/me", middleware.RequireAuth(jwtService, handler.GetProfile))
 	mux.HandleFunc("POST /auth/logout", middleware.RequireAuth(jwtService, handler.Logout))
+	mux.HandleFunc("GET /auth/security-events", middleware.RequireAuth(jwtService, handler.ListSecurityEvents))
+
+	return jwtService, nil
+}
+
+// parseTrustedProxies parses TRUSTED_PROXIES entries, accepting CIDRs and bare IPs
+func parseTrustedProxies(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
 
-	return jwtService
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
 }
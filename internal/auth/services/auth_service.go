@@ -2,8 +2,8 @@ package services
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"log/slog"
 	"regexp"
 	"time"
 
@@ -11,6 +11,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"go-api-template/internal/auth/models"
+	"go-api-template/internal/auth/repositories"
+	"go-api-template/pkg/pagination"
 )
 
 var (
@@ -22,20 +24,44 @@ var (
 	ErrNameRequired       = errors.New("name is required")
 )
 
+// SecurityEventSortFields are the fields GET /auth/security-events can be sorted by
+var SecurityEventSortFields = []string{"created_at"}
+
 // emailRegex is a simple email validation pattern
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 
+// UserRepository looks up and creates user accounts with their password hashes
+type UserRepository interface {
+	EmailExists(ctx context.Context, email string) (bool, error)
+	Create(ctx context.Context, user *models.AuthUser, passwordHash string) error
+	GetCredentials(ctx context.Context, email string) (*models.AuthUser, string, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.AuthUser, error)
+}
+
+// EventRepository stores and lists auth security events
+type EventRepository interface {
+	Create(ctx context.Context, event *models.SecurityEvent) error
+	ListByUser(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.SecurityEvent, error)
+}
+
+var (
+	_ UserRepository  = (*repositories.UserRepository)(nil)
+	_ EventRepository = (*repositories.EventRepository)(nil)
+)
+
 // AuthService handles authentication business logic
 type AuthService struct {
-	db         *sql.DB
+	users      UserRepository
 	jwtService *JWTService
+	events     EventRepository
 }
 
 // NewAuthService creates a new auth service
-func NewAuthService(db *sql.DB, jwtService *JWTService) *AuthService {
+func NewAuthService(users UserRepository, jwtService *JWTService, events EventRepository) *AuthService {
 	return &AuthService{
-		db:         db,
+		users:      users,
 		jwtService: jwtService,
+		events:     events,
 	}
 }
 
@@ -47,11 +73,7 @@ func (s *AuthService) Register(ctx context.Context, req *models.RegisterRequest)
 	}
 
 	// Check if email already exists
-	var exists bool
-	err := s.db.QueryRowContext(ctx,
-		"SELECT EXISTS(SELECT 1 FROM users WHERE email = $1 AND deleted_at IS NULL)",
-		req.Email,
-	).Scan(&exists)
+	exists, err := s.users.EmailExists(ctx, req.Email)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,24 +89,15 @@ func (s *AuthService) Register(ctx context.Context, req *models.RegisterRequest)
 
 	// Create user
 	user := &models.AuthUser{
-		ID:    uuid.New(),
 		Email: req.Email,
 		Name:  req.Name,
 	}
-	now := time.Now().UTC()
-
-	err = s.db.QueryRowContext(ctx,
-		`INSERT INTO users (id, email, name, password_hash, created_at, updated_at)
-		 VALUES ($1, $2, $3, $4, $5, $6)
-		 RETURNING created_at, updated_at`,
-		user.ID, user.Email, user.Name, string(hashedPassword), now, now,
-	).Scan(&user.CreatedAt, &user.UpdatedAt)
-	if err != nil {
+	if err := s.users.Create(ctx, user, string(hashedPassword)); err != nil {
 		return nil, nil, err
 	}
 
 	// Generate tokens
-	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email)
+	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email, time.Now())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,24 +106,17 @@ func (s *AuthService) Register(ctx context.Context, req *models.RegisterRequest)
 }
 
 // Login authenticates a user and returns tokens
-func (s *AuthService) Login(ctx context.Context, req *models.LoginRequest) (*models.AuthUser, *models.TokenPair, error) {
+func (s *AuthService) Login(ctx context.Context, req *models.LoginRequest, client models.ClientInfo) (*models.AuthUser, *models.TokenPair, error) {
 	// Validate input
 	if req.Email == "" || req.Password == "" {
 		return nil, nil, ErrInvalidCredentials
 	}
 
 	// Get user by email
-	var user models.AuthUser
-	var passwordHash string
+	user, passwordHash, err := s.users.GetCredentials(ctx, req.Email)
 
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, email, name, password_hash, created_at, updated_at
-		 FROM users
-		 WHERE email = $1 AND deleted_at IS NULL`,
-		req.Email,
-	).Scan(&user.ID, &user.Email, &user.Name, &passwordHash, &user.CreatedAt, &user.UpdatedAt)
-
-	if errors.Is(err, sql.ErrNoRows) {
+	// Unknown emails are not recorded: no user could ever read the event
+	if errors.Is(err, repositories.ErrUserNotFound) {
 		return nil, nil, ErrInvalidCredentials
 	}
 	if err != nil {
@@ -119,20 +125,23 @@ func (s *AuthService) Login(ctx context.Context, req *models.LoginRequest) (*mod
 
 	// Verify password
 	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Password)); err != nil {
+		s.recordEvent(ctx, models.EventLoginFailed, user.ID, user.Email, client)
 		return nil, nil, ErrInvalidCredentials
 	}
 
 	// Generate tokens
-	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email)
+	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email, time.Now())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return &user, tokens, nil
+	s.recordEvent(ctx, models.EventLoginSucceeded, user.ID, user.Email, client)
+
+	return user, tokens, nil
 }
 
 // RefreshTokens generates new tokens from a valid refresh token
-func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken string) (*models.AuthUser, *models.TokenPair, error) {
+func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.AuthUser, *models.TokenPair, error) {
 	// Validate refresh token
 	claims, err := s.jwtService.ValidateRefreshToken(refreshToken)
 	if err != nil {
@@ -140,15 +149,8 @@ func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken string) (*
 	}
 
 	// Get user from database to ensure they still exist and are not deleted
-	var user models.AuthUser
-	err = s.db.QueryRowContext(ctx,
-		`SELECT id, email, name, created_at, updated_at
-		 FROM users
-		 WHERE id = $1 AND deleted_at IS NULL`,
-		claims.UserID,
-	).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt, &user.UpdatedAt)
-
-	if errors.Is(err, sql.ErrNoRows) {
+	user, err := s.users.GetByID(ctx, claims.UserID)
+	if errors.Is(err, repositories.ErrUserNotFound) {
 		return nil, nil, ErrUserNotFound
 	}
 	if err != nil {
@@ -156,33 +158,64 @@ func (s *AuthService) RefreshTokens(ctx context.Context, refreshToken string) (*
 	}
 
 	// Generate new tokens
-	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email)
+	// Keep the original authentication time so refreshing doesn't count as a fresh login
+	tokens, err := s.jwtService.GenerateTokenPair(user.ID, user.Email, claims.AuthenticatedAt())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return &user, tokens, nil
+	s.recordEvent(ctx, models.EventTokenRefreshed, user.ID, user.Email, client)
+
+	return user, tokens, nil
 }
 
 // GetProfile retrieves the user profile by ID
 func (s *AuthService) GetProfile(ctx context.Context, userID uuid.UUID) (*models.AuthUser, error) {
-	var user models.AuthUser
-
-	err := s.db.QueryRowContext(ctx,
-		`SELECT id, email, name, created_at, updated_at
-		 FROM users
-		 WHERE id = $1 AND deleted_at IS NULL`,
-		userID,
-	).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt, &user.UpdatedAt)
-
-	if errors.Is(err, sql.ErrNoRows) {
+	user, err := s.users.GetByID(ctx, userID)
+	if errors.Is(err, repositories.ErrUserNotFound) {
 		return nil, ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &user, nil
+	return user, nil
+}
+
+// Logout records a logout event for the user.
+// Tokens are stateless, so the client is responsible for discarding them.
+func (s *AuthService) Logout(ctx context.Context, userID uuid.UUID, email string, client models.ClientInfo) {
+	s.recordEvent(ctx, models.EventLogout, userID, email, client)
+}
+
+// ListSecurityEvents retrieves a page of the user's auth security events.
+// Params are validated by pagination.Parse.
+func (s *AuthService) ListSecurityEvents(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.SecurityEvent, pagination.Meta, error) {
+	events, err := s.events.ListByUser(ctx, userID, p)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	events, meta := pagination.Page(events, p)
+	return events, meta, nil
+}
+
+// recordEvent stores a security event. Failures are logged but never block authentication.
+func (s *AuthService) recordEvent(ctx context.Context, eventType string, userID uuid.UUID, email string, client models.ClientInfo) {
+	event := &models.SecurityEvent{
+		UserID:    userID,
+		EventType: eventType,
+		Email:     email,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+	}
+
+	if err := s.events.Create(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "failed to record auth event",
+			slog.String("event_type", eventType),
+			slog.String("error", err.Error()),
+		)
+	}
 }
 
 // validateRegistration validates registration input
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-api-template/internal/auth/models"
+	"go-api-template/internal/auth/repositories"
+	"go-api-template/pkg/pagination"
+)
+
+// fakeUserRepository keeps accounts and password hashes by email
+type fakeUserRepository struct {
+	users  map[string]*models.AuthUser
+	hashes map[string]string
+}
+
+func (r *fakeUserRepository) EmailExists(_ context.Context, email string) (bool, error) {
+	_, ok := r.users[email]
+	return ok, nil
+}
+
+func (r *fakeUserRepository) Create(_ context.Context, user *models.AuthUser, passwordHash string) error {
+	user.ID = uuid.New()
+	r.users[user.Email] = user
+	r.hashes[user.Email] = passwordHash
+	return nil
+}
+
+func (r *fakeUserRepository) GetCredentials(_ context.Context, email string) (*models.AuthUser, string, error) {
+	user, ok := r.users[email]
+	if !ok {
+		return nil, "", repositories.ErrUserNotFound
+	}
+	return user, r.hashes[email], nil
+}
+
+func (r *fakeUserRepository) GetByID(_ context.Context, id uuid.UUID) (*models.AuthUser, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, repositories.ErrUserNotFound
+}
+
+// fakeEventRepository records events in order
+type fakeEventRepository struct {
+	events []models.SecurityEvent
+}
+
+func (r *fakeEventRepository) Create(_ context.Context, event *models.SecurityEvent) error {
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *fakeEventRepository) ListByUser(_ context.Context, userID uuid.UUID, p pagination.Params) ([]models.SecurityEvent, error) {
+	var events []models.SecurityEvent
+	for _, event := range r.events {
+		if event.UserID == userID && len(events) < p.FetchLimit() {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func newTestAuthService(t *testing.T) (*AuthService, *fakeUserRepository, *fakeEventRepository) {
+	t.Helper()
+
+	users := &fakeUserRepository{users: map[string]*models.AuthUser{}, hashes: map[string]string{}}
+	events := &fakeEventRepository{}
+	jwtService := NewJWTService("test-secret", 15*time.Minute, time.Hour)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	user := &models.AuthUser{Email: "user@example.com", Name: "User"}
+	if err := users.Create(context.Background(), user, string(hash)); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	return NewAuthService(users, jwtService, events), users, events
+}
+
+func TestAuthService_Register(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     models.RegisterRequest
+		wantErr error
+	}{
+		{name: "creates user", req: models.RegisterRequest{Email: "new@example.com", Password: "password123", Name: "New"}},
+		{name: "duplicate email", req: models.RegisterRequest{Email: "user@example.com", Password: "password123", Name: "Dup"}, wantErr: ErrEmailAlreadyExists},
+		{name: "weak password", req: models.RegisterRequest{Email: "new@example.com", Password: "short", Name: "New"}, wantErr: ErrWeakPassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, users, _ := newTestAuthService(t)
+
+			user, tokens, err := service.Register(context.Background(), &tt.req)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user.ID == uuid.Nil || tokens.AccessToken == "" {
+				t.Errorf("expected created user with tokens, got %+v %+v", user, tokens)
+			}
+			if bcrypt.CompareHashAndPassword([]byte(users.hashes[tt.req.Email]), []byte(tt.req.Password)) != nil {
+				t.Error("expected stored password hash to match")
+			}
+		})
+	}
+}
+
+func TestAuthService_Login(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       models.LoginRequest
+		wantErr   error
+		wantEvent string // empty when no event should be recorded
+	}{
+		{name: "valid credentials", req: models.LoginRequest{Email: "user@example.com", Password: "password123"}, wantEvent: models.EventLoginSucceeded},
+		{name: "wrong password", req: models.LoginRequest{Email: "user@example.com", Password: "wrong-password"}, wantErr: ErrInvalidCredentials, wantEvent: models.EventLoginFailed},
+		{name: "unknown email is not recorded", req: models.LoginRequest{Email: "nobody@example.com", Password: "password123"}, wantErr: ErrInvalidCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, users, events := newTestAuthService(t)
+			client := models.ClientInfo{IPAddress: "192.0.2.1", UserAgent: "test-agent"}
+
+			_, _, err := service.Login(context.Background(), &tt.req, client)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantEvent == "" {
+				if len(events.events) != 0 {
+					t.Errorf("expected no events, got %+v", events.events)
+				}
+				return
+			}
+			if len(events.events) != 1 {
+				t.Fatalf("expected 1 event, got %+v", events.events)
+			}
+			event := events.events[0]
+			if event.EventType != tt.wantEvent || event.UserID != users.users[tt.req.Email].ID || event.IPAddress != client.IPAddress {
+				t.Errorf("unexpected event %+v", event)
+			}
+		})
+	}
+}
+
+func TestAuthService_GetProfile_NotFound(t *testing.T) {
+	service, _, _ := newTestAuthService(t)
+
+	_, err := service.GetProfile(context.Background(), uuid.New())
+
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
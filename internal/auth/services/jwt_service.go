@@ -42,18 +42,19 @@ type jwtHeader struct {
 	Typ string `json:"typ"`
 }
 
-// GenerateTokenPair generates both access and refresh tokens
-func (s *JWTService) GenerateTokenPair(userID uuid.UUID, email string) (*models.TokenPair, error) {
+// GenerateTokenPair generates both access and refresh tokens.
+// authTime is when the user last entered their credentials.
+func (s *JWTService) GenerateTokenPair(userID uuid.UUID, email string, authTime time.Time) (*models.TokenPair, error) {
 	now := time.Now()
 
 	// Generate access token
-	accessToken, err := s.generateToken(userID, email, "access", now, s.accessTokenTTL)
+	accessToken, err := s.generateToken(userID, email, "access", now, authTime, s.accessTokenTTL)
 	if err != nil {
 		return nil, err
 	}
 
 	// Generate refresh token
-	refreshToken, err := s.generateToken(userID, email, "refresh", now, s.refreshTokenTTL)
+	refreshToken, err := s.generateToken(userID, email, "refresh", now, authTime, s.refreshTokenTTL)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,7 @@ func (s *JWTService) GenerateTokenPair(userID uuid.UUID, email string) (*models.
 }
 
 // generateToken creates a JWT token
-func (s *JWTService) generateToken(userID uuid.UUID, email, tokenType string, now time.Time, ttl time.Duration) (string, error) {
+func (s *JWTService) generateToken(userID uuid.UUID, email, tokenType string, now, authTime time.Time, ttl time.Duration) (string, error) {
 	header := jwtHeader{
 		Alg: "HS256",
 		Typ: "JWT",
@@ -79,6 +80,8 @@ func (s *JWTService) generateToken(userID uuid.UUID, email, tokenType string, no
 		Type:   tokenType,
 		Iat:    now.Unix(),
 		Exp:    now.Add(ttl).Unix(),
+
+		AuthTime: authTime.Unix(),
 	}
 
 	// Encode header
@@ -0,0 +1,11 @@
+// Package bundles embeds the translation bundles served by the i18n feature.
+// Each file is named after its locale (e.g. en.json, es.json) and contains a flat
+// JSON object of message keys to translated strings.
+package bundles
+
+import "embed"
+
+// FS contains all translation bundle files
+//
+//go:embed *.json
+var FS embed.FS
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"go-api-template/internal/i18n/services"
+	"go-api-template/pkg/response"
+)
+
+// I18nHandler handles HTTP requests for translation bundles
+type I18nHandler struct {
+	service *services.BundleService
+}
+
+// NewI18nHandler creates a new i18n handler
+func NewI18nHandler(service *services.BundleService) *I18nHandler {
+	return &I18nHandler{service: service}
+}
+
+// GetBundle godoc
+// @Summary      Get translation bundle
+// @Description  Get the translation messages for a locale. Unknown regional locales fall back to their base language (es-MX -> es). Responses carry an ETag; send it back in If-None-Match to get 304 Not Modified when the bundle is unchanged.
+// @Tags         I18n
+// @Produce      json
+// @Param        locale         path      string  true   "Locale (e.g. en, es, es-MX)"
+// @Param        If-None-Match  header    string  false  "ETag of the cached bundle"
+// @Success      200            {object}  models.BundleResponse
+// @Success      304            "Bundle not modified"
+// @Failure      404            {object}  response.Response
+// @Router       /i18n/{locale} [get]
+func (h *I18nHandler) GetBundle(w http.ResponseWriter, r *http.Request) {
+	bundle, err := h.service.Get(r.PathValue("locale"))
+	if errors.Is(err, services.ErrLocaleNotFound) {
+		response.NotFound(w, map[string]string{
+			"locale": "Locale not available. Available locales: " + strings.Join(h.service.Locales(), ", "),
+		})
+		return
+	}
+	if err != nil {
+		response.InternalError(w, "Failed to load translations")
+		return
+	}
+
+	etag := `"` + bundle.Version + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=300, must-revalidate")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	response.Success(w, bundle)
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag (weak comparison)
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+//nolint:errcheck // Test file - error checking not critical for test assertions
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"go-api-template/internal/i18n/services"
+)
+
+func newTestHandler(t *testing.T) *I18nHandler {
+	t.Helper()
+	service, err := services.NewBundleService(fstest.MapFS{
+		"en.json": {Data: []byte(`{"hello": "Hello"}`)},
+		"es.json": {Data: []byte(`{"hello": "Hola"}`)},
+	})
+	if err != nil {
+		t.Fatalf("NewBundleService() error = %v", err)
+	}
+	return NewI18nHandler(service)
+}
+
+func serve(h *I18nHandler, locale, ifNoneMatch string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /i18n/{locale}", h.GetBundle)
+
+	req := httptest.NewRequest(http.MethodGet, "/i18n/"+locale, nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	return w
+}
+
+func TestGetBundle(t *testing.T) {
+	h := newTestHandler(t)
+
+	w := serve(h, "es-MX", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected ETag header")
+	}
+
+	var resp struct {
+		Status string `json:"status"`
+		Data   struct {
+			Messages map[string]string `json:"messages"`
+			Locale   string            `json:"locale"`
+			Version  string            `json:"version"`
+		} `json:"data"`
+	}
+	json.NewDecoder(w.Body).Decode(&resp)
+
+	if resp.Data.Locale != "es" || resp.Data.Messages["hello"] != "Hola" {
+		t.Errorf("unexpected bundle: %+v", resp.Data)
+	}
+	if etag != `"`+resp.Data.Version+`"` {
+		t.Errorf("ETag %s does not match version %s", etag, resp.Data.Version)
+	}
+}
+
+func TestGetBundle_NotModified(t *testing.T) {
+	h := newTestHandler(t)
+	etag := serve(h, "en", "").Header().Get("ETag")
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		{name: "matching etag", ifNoneMatch: etag, wantStatus: http.StatusNotModified},
+		{name: "weak matching etag", ifNoneMatch: "W/" + etag, wantStatus: http.StatusNotModified},
+		{name: "etag in list", ifNoneMatch: `"stale", ` + etag, wantStatus: http.StatusNotModified},
+		{name: "stale etag", ifNoneMatch: `"stale"`, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(h, "en", tt.ifNoneMatch)
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus == http.StatusNotModified && w.Body.Len() != 0 {
+				t.Error("expected empty body on 304")
+			}
+		})
+	}
+}
+
+func TestGetBundle_UnknownLocale(t *testing.T) {
+	w := serve(newTestHandler(t), "fr", "")
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", w.Code)
+	}
+}
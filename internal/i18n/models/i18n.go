@@ -0,0 +1,14 @@
+package models
+
+// Bundle represents the translation messages of a single locale
+type Bundle struct {
+	Messages map[string]string `json:"messages"`
+	Locale   string            `json:"locale" example:"es"`
+	Version  string            `json:"version" example:"3f2a9c1b7d4e"`
+}
+
+// BundleResponse represents a successful bundle response (JSend format)
+type BundleResponse struct {
+	Status string `json:"status" example:"success"`
+	Data   Bundle `json:"data"`
+}
@@ -0,0 +1,22 @@
+package i18n
+
+import (
+	"net/http"
+
+	"go-api-template/internal/i18n/bundles"
+	"go-api-template/internal/i18n/handlers"
+	"go-api-template/internal/i18n/services"
+)
+
+// RegisterRoutes registers translation bundle routes (public)
+func RegisterRoutes(mux *http.ServeMux) error {
+	service, err := services.NewBundleService(bundles.FS)
+	if err != nil {
+		return err
+	}
+	handler := handlers.NewI18nHandler(service)
+
+	mux.HandleFunc("GET /i18n/{locale}", handler.GetBundle)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"go-api-template/internal/i18n/models"
+)
+
+var (
+	ErrLocaleNotFound = errors.New("locale not found")
+)
+
+// versionLength is the number of hex characters of the content hash used as bundle version
+const versionLength = 12
+
+// BundleService serves translation bundles loaded once at startup
+type BundleService struct {
+	bundles map[string]*models.Bundle
+}
+
+// NewBundleService loads and validates every *.json bundle in fsys.
+// The bundle version is derived from its content, so any copy change yields a new version.
+func NewBundleService(fsys fs.FS) (*BundleService, error) {
+	files, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make(map[string]*models.Bundle, len(files))
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return nil, fmt.Errorf("invalid bundle %s: %w", file, err)
+		}
+
+		// Hash the re-encoded messages (sorted keys) so formatting changes don't bump the version
+		canonical, err := json.Marshal(messages)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(canonical)
+
+		locale := normalizeLocale(strings.TrimSuffix(path.Base(file), ".json"))
+		bundles[locale] = &models.Bundle{
+			Locale:   locale,
+			Version:  hex.EncodeToString(sum[:])[:versionLength],
+			Messages: messages,
+		}
+	}
+
+	return &BundleService{bundles: bundles}, nil
+}
+
+// Get returns the bundle for a locale, falling back to its base language
+// (e.g. "es-MX" falls back to "es")
+func (s *BundleService) Get(locale string) (*models.Bundle, error) {
+	locale = normalizeLocale(locale)
+	if bundle, ok := s.bundles[locale]; ok {
+		return bundle, nil
+	}
+
+	if base, _, found := strings.Cut(locale, "-"); found {
+		if bundle, ok := s.bundles[base]; ok {
+			return bundle, nil
+		}
+	}
+
+	return nil, ErrLocaleNotFound
+}
+
+// Locales returns the available locales in alphabetical order
+func (s *BundleService) Locales() []string {
+	locales := make([]string, 0, len(s.bundles))
+	for locale := range s.bundles {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// normalizeLocale lowercases a locale tag and uses "-" as separator ("es_MX" -> "es-mx")
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
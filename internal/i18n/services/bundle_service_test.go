@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"go-api-template/internal/i18n/bundles"
+)
+
+func TestNewBundleService_EmbeddedBundles(t *testing.T) {
+	service, err := NewBundleService(bundles.FS)
+	if err != nil {
+		t.Fatalf("embedded bundles failed to load: %v", err)
+	}
+
+	en, err := service.Get("en")
+	if err != nil {
+		t.Fatalf("Get(en) error = %v", err)
+	}
+
+	// Every locale must translate the same keys as the English bundle
+	for _, locale := range service.Locales() {
+		bundle, err := service.Get(locale)
+		if err != nil {
+			t.Fatalf("Get(%s) error = %v", locale, err)
+		}
+		for key := range en.Messages {
+			if _, ok := bundle.Messages[key]; !ok {
+				t.Errorf("locale %s is missing key %q", locale, key)
+			}
+		}
+	}
+}
+
+func TestBundleService_Get(t *testing.T) {
+	service, err := NewBundleService(fstest.MapFS{
+		"en.json":    {Data: []byte(`{"hello": "Hello"}`)},
+		"es.json":    {Data: []byte(`{"hello": "Hola"}`)},
+		"pt_BR.json": {Data: []byte(`{"hello": "Olá"}`)},
+	})
+	if err != nil {
+		t.Fatalf("NewBundleService() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		locale     string
+		wantLocale string
+		wantErr    error
+	}{
+		{name: "exact match", locale: "es", wantLocale: "es"},
+		{name: "case insensitive", locale: "EN", wantLocale: "en"},
+		{name: "regional falls back to base", locale: "es-MX", wantLocale: "es"},
+		{name: "underscore separator", locale: "pt-br", wantLocale: "pt-br"},
+		{name: "regional without base", locale: "pt-PT", wantErr: ErrLocaleNotFound},
+		{name: "unknown locale", locale: "fr", wantErr: ErrLocaleNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundle, err := service.Get(tt.locale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get(%q) error = %v, want %v", tt.locale, err, tt.wantErr)
+			}
+			if tt.wantErr == nil && bundle.Locale != tt.wantLocale {
+				t.Errorf("Get(%q) locale = %q, want %q", tt.locale, bundle.Locale, tt.wantLocale)
+			}
+		})
+	}
+}
+
+func TestBundleService_Version(t *testing.T) {
+	load := func(data string) string {
+		t.Helper()
+		service, err := NewBundleService(fstest.MapFS{"en.json": {Data: []byte(data)}})
+		if err != nil {
+			t.Fatalf("NewBundleService() error = %v", err)
+		}
+		bundle, err := service.Get("en")
+		if err != nil {
+			t.Fatalf("Get(en) error = %v", err)
+		}
+		return bundle.Version
+	}
+
+	original := load(`{"a": "1", "b": "2"}`)
+	if reformatted := load("{\n  \"b\": \"2\",\n  \"a\": \"1\"\n}"); reformatted != original {
+		t.Errorf("formatting change bumped version: %s -> %s", original, reformatted)
+	}
+	if changed := load(`{"a": "1", "b": "3"}`); changed == original {
+		t.Error("copy change did not bump version")
+	}
+}
+
+func TestNewBundleService_InvalidBundle(t *testing.T) {
+	_, err := NewBundleService(fstest.MapFS{"en.json": {Data: []byte(`{"nested": {"a": "b"}}`)}})
+	if err == nil {
+		t.Error("NewBundleService() expected error for non-string messages")
+	}
+}
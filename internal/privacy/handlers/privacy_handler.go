@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"go-api-template/internal/privacy/models"
+	"go-api-template/internal/privacy/services"
+	"go-api-template/pkg/middleware"
+	"go-api-template/pkg/response"
+)
+
+// PrivacyHandler handles HTTP requests for personal data export and erasure
+type PrivacyHandler struct {
+	service *services.PrivacyService
+}
+
+// NewPrivacyHandler creates a new privacy handler
+func NewPrivacyHandler(service *services.PrivacyService) *PrivacyHandler {
+	return &PrivacyHandler{service: service}
+}
+
+// ExportData godoc
+// @Summary      Export personal data
+// @Description  Download all personal data held about the authenticated user (profile and security events)
+// @Tags         Privacy
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  models.DataExportResponse
+// @Failure      401  {object}  response.Response
+// @Failure      404  {object}  response.Response
+// @Failure      500  {object}  response.Response
+// @Router       /users/me/data-export [post]
+func (h *PrivacyHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context (set by auth middleware)
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
+		return
+	}
+
+	export, err := h.service.Export(r.Context(), userID)
+	if errors.Is(err, services.ErrUserNotFound) {
+		response.NotFound(w, map[string]string{"user": "User not found"})
+		return
+	}
+	if err != nil {
+		response.InternalError(w, "Failed to export data")
+		return
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="data-export.json"`)
+	w.Header().Set("Cache-Control", "no-store")
+	response.Success(w, export)
+}
+
+// RequestErasure godoc
+// @Summary      Request data erasure
+// @Description  Create a request to delete all personal data of the authenticated user. Requests are reviewed before deletion. Requires a recent login (see JWT_FRESH_AUTH_MAX_AGE).
+// @Tags         Privacy
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      models.CreateErasureRequest  false  "Optional reason"
+// @Success      202      {object}  models.ErasureRequestResponse
+// @Failure      400      {object}  response.Response
+// @Failure      401      {object}  response.Response
+// @Failure      409      {object}  response.Response
+// @Failure      500      {object}  response.Response
+// @Router       /users/me/erasure-request [post]
+func (h *PrivacyHandler) RequestErasure(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
+		return
+	}
+
+	// Body is optional
+	var req models.CreateErasureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.BadRequest(w, map[string]string{"body": "Invalid JSON"})
+		return
+	}
+
+	erasure, err := h.service.RequestErasure(r.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReasonTooLong):
+			response.BadRequest(w, map[string]string{"reason": "Reason must be at most 1000 characters"})
+		case errors.Is(err, services.ErrErasureAlreadyPending):
+			response.Conflict(w, map[string]string{"erasure_request": "An erasure request is already pending"})
+		default:
+			response.InternalError(w, "Failed to create erasure request")
+		}
+		return
+	}
+
+	response.SuccessWithStatus(w, http.StatusAccepted, erasure)
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/privacy/models"
+	"go-api-template/internal/privacy/repositories"
+	"go-api-template/internal/privacy/services"
+	"go-api-template/pkg/middleware"
+)
+
+// mockPrivacyRepository is an in-memory repository for handler tests
+type mockPrivacyRepository struct {
+	profiles map[uuid.UUID]*models.ProfileExport
+	pending  map[uuid.UUID]bool
+}
+
+func newMockPrivacyRepository() *mockPrivacyRepository {
+	return &mockPrivacyRepository{
+		profiles: make(map[uuid.UUID]*models.ProfileExport),
+		pending:  make(map[uuid.UUID]bool),
+	}
+}
+
+func (r *mockPrivacyRepository) GetProfile(_ context.Context, userID uuid.UUID) (*models.ProfileExport, error) {
+	profile, ok := r.profiles[userID]
+	if !ok {
+		return nil, repositories.ErrUserNotFound
+	}
+	return profile, nil
+}
+
+func (r *mockPrivacyRepository) ListSecurityEvents(_ context.Context, _ uuid.UUID) ([]models.SecurityEventExport, error) {
+	return []models.SecurityEventExport{}, nil
+}
+
+func (r *mockPrivacyRepository) HasPendingErasureRequest(_ context.Context, userID uuid.UUID) (bool, error) {
+	return r.pending[userID], nil
+}
+
+func (r *mockPrivacyRepository) CreateErasureRequest(_ context.Context, req *models.ErasureRequest) error {
+	req.ID = uuid.New()
+	req.Status = models.ErasureStatusPending
+	r.pending[req.UserID] = true
+	return nil
+}
+
+func withUser(req *http.Request, id uuid.UUID) *http.Request {
+	return req.WithContext(middleware.WithUser(req.Context(), id, "me@example.com", time.Now()))
+}
+
+func TestExportData(t *testing.T) {
+	tests := []struct {
+		name       string
+		anonymous  bool
+		missing    bool
+		wantStatus int
+	}{
+		{name: "exports data", wantStatus: http.StatusOK},
+		{name: "unauthenticated", anonymous: true, wantStatus: http.StatusUnauthorized},
+		{name: "deleted account", missing: true, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockPrivacyRepository()
+			me := uuid.New()
+			if !tt.missing {
+				repo.profiles[me] = &models.ProfileExport{ID: me, Email: "me@example.com", Name: "Me"}
+			}
+			handler := NewPrivacyHandler(services.NewPrivacyService(repo))
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/data-export", nil)
+			if !tt.anonymous {
+				req = withUser(req, me)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ExportData(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				if got := w.Header().Get("Content-Disposition"); got != "" {
+					t.Errorf("error response has Content-Disposition %q", got)
+				}
+				return
+			}
+
+			if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="data-export.json"` {
+				t.Errorf("unexpected Content-Disposition %q", got)
+			}
+			if got := w.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("unexpected Cache-Control %q", got)
+			}
+
+			var resp map[string]any
+			_ = json.NewDecoder(w.Body).Decode(&resp)
+			data, _ := resp["data"].(map[string]any)
+			profile, _ := data["profile"].(map[string]any)
+			if profile["email"] != "me@example.com" {
+				t.Errorf("unexpected export %v", data)
+			}
+		})
+	}
+}
+
+func TestRequestErasure(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		anonymous  bool
+		pending    bool
+		wantStatus int
+		wantField  string // field expected in a fail response
+	}{
+		{name: "without body", body: "", wantStatus: http.StatusAccepted},
+		{name: "with reason", body: `{"reason":"closing my account"}`, wantStatus: http.StatusAccepted},
+		{name: "unauthenticated", body: "", anonymous: true, wantStatus: http.StatusUnauthorized, wantField: "auth"},
+		{name: "invalid JSON", body: `{"reason":`, wantStatus: http.StatusBadRequest, wantField: "body"},
+		{name: "reason too long", body: `{"reason":"` + strings.Repeat("a", 1001) + `"}`, wantStatus: http.StatusBadRequest, wantField: "reason"},
+		{name: "already pending", body: "", pending: true, wantStatus: http.StatusConflict, wantField: "erasure_request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockPrivacyRepository()
+			me := uuid.New()
+			repo.pending[me] = tt.pending
+			handler := NewPrivacyHandler(services.NewPrivacyService(repo))
+
+			req := httptest.NewRequest(http.MethodPost, "/users/me/erasure-request", strings.NewReader(tt.body))
+			if !tt.anonymous {
+				req = withUser(req, me)
+			}
+			w := httptest.NewRecorder()
+
+			handler.RequestErasure(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+
+			var resp map[string]any
+			_ = json.NewDecoder(w.Body).Decode(&resp)
+			data, _ := resp["data"].(map[string]any)
+			if tt.wantField != "" {
+				if resp["status"] != "fail" || data[tt.wantField] == nil {
+					t.Errorf("expected fail response for %q, got %v", tt.wantField, resp)
+				}
+				return
+			}
+			if data["status"] != models.ErasureStatusPending {
+				t.Errorf("unexpected erasure request %v", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Erasure request statuses
+const (
+	ErasureStatusPending = "pending"
+)
+
+// ProfileExport contains the user's profile data
+type ProfileExport struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AvatarURL *string   `json:"avatar_url,omitempty" example:"https://cdn.example.com/avatars/john.png"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Email     string    `json:"email" example:"user@example.com"`
+	Name      string    `json:"name" example:"John Doe"`
+}
+
+// SecurityEventExport contains a single auth security event
+type SecurityEventExport struct {
+	CreatedAt time.Time `json:"created_at"`
+	EventType string    `json:"event_type" example:"login_succeeded"`
+	IPAddress string    `json:"ip_address" example:"203.0.113.42"`
+	UserAgent string    `json:"user_agent" example:"Mozilla/5.0"`
+}
+
+// DataExport contains all personal data held about a user
+type DataExport struct {
+	ExportedAt     time.Time             `json:"exported_at"`
+	Profile        ProfileExport         `json:"profile"`
+	SecurityEvents []SecurityEventExport `json:"security_events"`
+}
+
+// ErasureRequest represents a user's request to have their personal data deleted
+type ErasureRequest struct {
+	ID         uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID     uuid.UUID  `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Status     string     `json:"status" example:"pending"`
+	Reason     string     `json:"reason" example:"Closing my account"`
+}
+
+// CreateErasureRequest represents the request body for requesting data erasure
+type CreateErasureRequest struct {
+	Reason string `json:"reason,omitempty" example:"Closing my account"`
+}
+
+// DataExportResponse represents a successful data export response (JSend format)
+type DataExportResponse struct {
+	Status string     `json:"status" example:"success"`
+	Data   DataExport `json:"data"`
+}
+
+// ErasureRequestResponse represents a successful erasure request response (JSend format)
+type ErasureRequestResponse struct {
+	Status string         `json:"status" example:"success"`
+	Data   ErasureRequest `json:"data"`
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"go-api-template/internal/privacy/models"
+)
+
+var (
+	ErrUserNotFound          = errors.New("user not found")
+	ErrErasureAlreadyPending = errors.New("an erasure request is already pending")
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations
+const uniqueViolation = "23505"
+
+// PrivacyRepository handles database operations for data export and erasure requests.
+// Exports read from the primary: a lagging replica could hand the user an
+// incomplete copy of their data right after they changed it.
+type PrivacyRepository struct {
+	db *sql.DB
+}
+
+// NewPrivacyRepository creates a new privacy repository
+func NewPrivacyRepository(db *sql.DB) *PrivacyRepository {
+	return &PrivacyRepository{db: db}
+}
+
+// GetProfile retrieves the profile data of a user
+func (r *PrivacyRepository) GetProfile(ctx context.Context, userID uuid.UUID) (*models.ProfileExport, error) {
+	query := `
+		SELECT id, email, name, avatar_url, created_at, updated_at
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	profile := &models.ProfileExport{}
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&profile.ID,
+		&profile.Email,
+		&profile.Name,
+		&profile.AvatarURL,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// ListSecurityEvents retrieves all auth security events of a user, newest first
+func (r *PrivacyRepository) ListSecurityEvents(ctx context.Context, userID uuid.UUID) ([]models.SecurityEventExport, error) {
+	query := `
+		SELECT event_type, ip_address, user_agent, created_at
+		FROM auth_events
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck // rows.Close() error is not critical
+
+	events := []models.SecurityEventExport{}
+	for rows.Next() {
+		var event models.SecurityEventExport
+		err := rows.Scan(
+			&event.EventType,
+			&event.IPAddress,
+			&event.UserAgent,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// HasPendingErasureRequest checks if the user already has an erasure request awaiting review
+func (r *PrivacyRepository) HasPendingErasureRequest(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM erasure_requests WHERE user_id = $1 AND status = $2)",
+		userID, models.ErasureStatusPending,
+	).Scan(&exists)
+
+	return exists, err
+}
+
+// CreateErasureRequest inserts a new pending erasure request
+func (r *PrivacyRepository) CreateErasureRequest(ctx context.Context, req *models.ErasureRequest) error {
+	query := `
+		INSERT INTO erasure_requests (id, user_id, status, reason, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at`
+
+	req.ID = uuid.New()
+	req.Status = models.ErasureStatusPending
+	now := time.Now().UTC()
+
+	err := r.db.QueryRowContext(ctx, query,
+		req.ID,
+		req.UserID,
+		req.Status,
+		req.Reason,
+		now,
+		now,
+	).Scan(&req.CreatedAt, &req.UpdatedAt)
+
+	// A concurrent request won the race for idx_erasure_requests_user_pending
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return ErrErasureAlreadyPending
+	}
+
+	return err
+}
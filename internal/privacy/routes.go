@@ -0,0 +1,27 @@
+package privacy
+
+import (
+	"net/http"
+	"time"
+
+	"go-api-template/database"
+	"go-api-template/internal/auth/services"
+	"go-api-template/internal/privacy/handlers"
+	"go-api-template/internal/privacy/repositories"
+	privacyservices "go-api-template/internal/privacy/services"
+	"go-api-template/pkg/config"
+	"go-api-template/pkg/middleware"
+)
+
+// RegisterRoutes registers personal data export and erasure routes (protected with auth)
+func RegisterRoutes(mux *http.ServeMux, db *database.DB, jwtService *services.JWTService, cfg *config.Config) {
+	repo := repositories.NewPrivacyRepository(db.Writer())
+	service := privacyservices.NewPrivacyService(repo)
+	handler := handlers.NewPrivacyHandler(service)
+
+	// Requesting erasure requires a recent login
+	freshAuthMaxAge := time.Duration(cfg.JWT.FreshAuthMaxAge) * time.Minute
+
+	mux.HandleFunc("POST /users/me/data-export", middleware.RequireAuth(jwtService, handler.ExportData))
+	mux.HandleFunc("POST /users/me/erasure-request", middleware.RequireAuth(jwtService, middleware.RequireFreshAuth(freshAuthMaxAge, handler.RequestErasure)))
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/privacy/models"
+	"go-api-template/internal/privacy/repositories"
+)
+
+var (
+	ErrUserNotFound          = errors.New("user not found")
+	ErrErasureAlreadyPending = errors.New("an erasure request is already pending")
+	ErrReasonTooLong         = errors.New("reason must be at most 1000 characters")
+)
+
+// maxReasonLength is the maximum length of an erasure request reason
+const maxReasonLength = 1000
+
+// PrivacyRepository reads a user's personal data and stores erasure requests
+type PrivacyRepository interface {
+	GetProfile(ctx context.Context, userID uuid.UUID) (*models.ProfileExport, error)
+	ListSecurityEvents(ctx context.Context, userID uuid.UUID) ([]models.SecurityEventExport, error)
+	HasPendingErasureRequest(ctx context.Context, userID uuid.UUID) (bool, error)
+	CreateErasureRequest(ctx context.Context, req *models.ErasureRequest) error
+}
+
+var _ PrivacyRepository = (*repositories.PrivacyRepository)(nil)
+
+// PrivacyService handles personal data export and erasure requests
+type PrivacyService struct {
+	repo PrivacyRepository
+}
+
+// NewPrivacyService creates a new privacy service
+func NewPrivacyService(repo PrivacyRepository) *PrivacyService {
+	return &PrivacyService{repo: repo}
+}
+
+// Export assembles all personal data held about the user
+func (s *PrivacyService) Export(ctx context.Context, userID uuid.UUID) (*models.DataExport, error) {
+	profile, err := s.repo.GetProfile(ctx, userID)
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.ListSecurityEvents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.DataExport{
+		ExportedAt:     time.Now().UTC(),
+		Profile:        *profile,
+		SecurityEvents: events,
+	}, nil
+}
+
+// RequestErasure creates a pending erasure request for review
+func (s *PrivacyService) RequestErasure(ctx context.Context, userID uuid.UUID, req *models.CreateErasureRequest) (*models.ErasureRequest, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if utf8.RuneCountInString(reason) > maxReasonLength {
+		return nil, ErrReasonTooLong
+	}
+
+	pending, err := s.repo.HasPendingErasureRequest(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, ErrErasureAlreadyPending
+	}
+
+	erasure := &models.ErasureRequest{
+		UserID: userID,
+		Reason: reason,
+	}
+
+	// The pending check above is racy; the unique index catches concurrent requests
+	err = s.repo.CreateErasureRequest(ctx, erasure)
+	if errors.Is(err, repositories.ErrErasureAlreadyPending) {
+		return nil, ErrErasureAlreadyPending
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return erasure, nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/privacy/models"
+	"go-api-template/internal/privacy/repositories"
+)
+
+// fakePrivacyRepository keeps profiles, events and pending erasure requests by user
+type fakePrivacyRepository struct {
+	profiles map[uuid.UUID]*models.ProfileExport
+	events   map[uuid.UUID][]models.SecurityEventExport
+	pending  map[uuid.UUID]bool
+	err      error
+
+	// staleCheck makes HasPendingErasureRequest miss existing requests,
+	// simulating a concurrent request inserted after the check
+	staleCheck bool
+}
+
+func newFakePrivacyRepository(profiles ...*models.ProfileExport) *fakePrivacyRepository {
+	repo := &fakePrivacyRepository{
+		profiles: make(map[uuid.UUID]*models.ProfileExport),
+		events:   make(map[uuid.UUID][]models.SecurityEventExport),
+		pending:  make(map[uuid.UUID]bool),
+	}
+	for _, p := range profiles {
+		repo.profiles[p.ID] = p
+	}
+	return repo
+}
+
+func (r *fakePrivacyRepository) GetProfile(_ context.Context, userID uuid.UUID) (*models.ProfileExport, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	profile, ok := r.profiles[userID]
+	if !ok {
+		return nil, repositories.ErrUserNotFound
+	}
+	return profile, nil
+}
+
+func (r *fakePrivacyRepository) ListSecurityEvents(_ context.Context, userID uuid.UUID) ([]models.SecurityEventExport, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.events[userID], nil
+}
+
+func (r *fakePrivacyRepository) HasPendingErasureRequest(_ context.Context, userID uuid.UUID) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	return r.pending[userID] && !r.staleCheck, nil
+}
+
+func (r *fakePrivacyRepository) CreateErasureRequest(_ context.Context, req *models.ErasureRequest) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.pending[req.UserID] {
+		return repositories.ErrErasureAlreadyPending
+	}
+	req.ID = uuid.New()
+	req.Status = models.ErasureStatusPending
+	r.pending[req.UserID] = true
+	return nil
+}
+
+func TestPrivacyService_Export(t *testing.T) {
+	profile := &models.ProfileExport{ID: uuid.New(), Email: "user@example.com", Name: "User"}
+	errDB := errors.New("connection reset")
+
+	tests := []struct {
+		name    string
+		userID  uuid.UUID
+		repoErr error
+		wantErr error
+	}{
+		{name: "exports data", userID: profile.ID},
+		{name: "user not found", userID: uuid.New(), wantErr: ErrUserNotFound},
+		{name: "repository error", userID: profile.ID, repoErr: errDB, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakePrivacyRepository(profile)
+			repo.events[profile.ID] = []models.SecurityEventExport{{EventType: "login_succeeded"}}
+			repo.err = tt.repoErr
+			service := NewPrivacyService(repo)
+
+			export, err := service.Export(context.Background(), tt.userID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if export.Profile.Email != profile.Email || len(export.SecurityEvents) != 1 {
+				t.Errorf("unexpected export %+v", export)
+			}
+			if export.ExportedAt.IsZero() {
+				t.Error("expected ExportedAt to be set")
+			}
+		})
+	}
+}
+
+func TestPrivacyService_RequestErasure(t *testing.T) {
+	tests := []struct {
+		name       string
+		reason     string
+		pending    bool
+		staleCheck bool
+		wantErr    error
+		wantReason string
+	}{
+		{name: "creates request", reason: "  Closing my account  ", wantReason: "Closing my account"},
+		{name: "reason at limit", reason: strings.Repeat("é", maxReasonLength), wantReason: strings.Repeat("é", maxReasonLength)},
+		{name: "reason too long", reason: strings.Repeat("a", maxReasonLength+1), wantErr: ErrReasonTooLong},
+		{name: "already pending", pending: true, wantErr: ErrErasureAlreadyPending},
+		{name: "concurrent request", pending: true, staleCheck: true, wantErr: ErrErasureAlreadyPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			repo := newFakePrivacyRepository()
+			repo.pending[userID] = tt.pending
+			repo.staleCheck = tt.staleCheck
+			service := NewPrivacyService(repo)
+
+			erasure, err := service.RequestErasure(context.Background(), userID, &models.CreateErasureRequest{Reason: tt.reason})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if erasure.UserID != userID || erasure.Status != models.ErasureStatusPending || erasure.Reason != tt.wantReason {
+				t.Errorf("unexpected erasure request %+v", erasure)
+			}
+			if !repo.pending[userID] {
+				t.Error("expected request to be stored")
+			}
+		})
+	}
+}
@@ -4,12 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"strconv"
 
 	"github.com/google/uuid"
 
 	"go-api-template/internal/users/models"
 	"go-api-template/internal/users/services"
+	"go-api-template/pkg/middleware"
+	"go-api-template/pkg/pagination"
 	"go-api-template/pkg/response"
 )
 
@@ -29,27 +30,32 @@ func NewUserHandler(service *services.UserService) *UserHandler {
 // @Tags         Users
 // @Produce      json
 // @Security     BearerAuth
-// @Param        limit   query     int  false  "Limit (default 20, max 100)"
-// @Param        offset  query     int  false  "Offset (default 0)"
+// @Param        limit   query     int     false  "Limit (default 20, max 100)"
+// @Param        offset  query     int     false  "Offset (default 0)"
+// @Param        sort    query     string  false  "Sort field (default created_at)"  Enums(created_at, name, email)
+// @Param        order   query     string  false  "Sort order (default desc)"        Enums(asc, desc)
 // @Success      200     {object}  models.UsersListResponse
+// @Failure      400     {object}  response.Response
 // @Failure      401     {object}  response.Response
 // @Failure      500     {object}  response.Response
 // @Router       /users [get]
 func (h *UserHandler) List(w http.ResponseWriter, r *http.Request) {
-	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))   //nolint:errcheck // default 0 is fine
-	offset, _ := strconv.Atoi(r.URL.Query().Get("offset")) //nolint:errcheck // default 0 is fine
-
-	users, err := h.service.List(r.Context(), limit, offset)
+	params, err := pagination.Parse(r.URL.Query(), pagination.Options{
+		SortFields:  services.UserSortFields,
+		DefaultSort: "created_at",
+	})
 	if err != nil {
-		response.InternalError(w, "Failed to retrieve users")
+		response.BadRequest(w, pagination.Fields(err))
 		return
 	}
 
-	if users == nil {
-		users = []models.User{}
+	users, meta, err := h.service.List(r.Context(), params)
+	if err != nil {
+		response.InternalError(w, "Failed to retrieve users")
+		return
 	}
 
-	response.Success(w, users)
+	response.SuccessWithMeta(w, users, meta)
 }
 
 // GetByID godoc
@@ -131,7 +137,7 @@ func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 // Update godoc
 // @Summary      Update a user
-// @Description  Update user's email and/or name
+// @Description  Update user's email and/or name. Requires a recent login (see JWT_FRESH_AUTH_MAX_AGE).
 // @Tags         Users
 // @Accept       json
 // @Produce      json
@@ -176,9 +182,103 @@ func (h *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, user)
 }
 
+// UpdateMe godoc
+// @Summary      Update current user profile
+// @Description  Update the authenticated user's name and/or avatar. An empty avatar_url removes the avatar.
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      models.UpdateProfileRequest  true  "Profile fields to update"
+// @Success      200      {object}  models.UserResponse
+// @Failure      400      {object}  response.Response
+// @Failure      401      {object}  response.Response
+// @Failure      404      {object}  response.Response
+// @Failure      500      {object}  response.Response
+// @Router       /users/me [patch]
+func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context (set by auth middleware)
+	userID, ok := middleware.UserID(r.Context())
+	if !ok {
+		response.Unauthorized(w, map[string]string{"auth": "User not authenticated"})
+		return
+	}
+
+	var req models.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.BadRequest(w, map[string]string{"body": "Invalid JSON"})
+		return
+	}
+
+	user, err := h.service.UpdateProfile(r.Context(), userID, &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrNameRequired):
+			response.BadRequest(w, map[string]string{"name": "Name cannot be empty"})
+		case errors.Is(err, services.ErrNameTooLong):
+			response.BadRequest(w, map[string]string{"name": "Name must be at most 255 characters"})
+		case errors.Is(err, services.ErrInvalidAvatarURL):
+			response.BadRequest(w, map[string]string{"avatar_url": "Avatar URL must be an absolute http(s) URL"})
+		case errors.Is(err, services.ErrUserNotFound):
+			response.NotFound(w, map[string]string{"user": "User not found"})
+		default:
+			response.InternalError(w, "Failed to update profile")
+		}
+		return
+	}
+
+	response.Success(w, user)
+}
+
+// ListProfileChanges godoc
+// @Summary      List a user's profile changes
+// @Description  Get the history of name and avatar changes made through PATCH /users/me, for support
+// @Tags         Users
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string  true   "User ID (UUID)"
+// @Param        limit   query     int     false  "Limit (default 20, max 100)"
+// @Param        offset  query     int     false  "Offset (default 0)"
+// @Param        sort    query     string  false  "Sort field (default created_at)"  Enums(created_at)
+// @Param        order   query     string  false  "Sort order (default desc)"        Enums(asc, desc)
+// @Success      200     {object}  models.ProfileChangesListResponse
+// @Failure      400     {object}  response.Response
+// @Failure      401     {object}  response.Response
+// @Failure      404     {object}  response.Response
+// @Failure      500     {object}  response.Response
+// @Router       /users/{id}/profile-changes [get]
+func (h *UserHandler) ListProfileChanges(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		response.BadRequest(w, map[string]string{"id": "Invalid UUID format"})
+		return
+	}
+
+	params, err := pagination.Parse(r.URL.Query(), pagination.Options{
+		SortFields:  services.ProfileChangeSortFields,
+		DefaultSort: "created_at",
+	})
+	if err != nil {
+		response.BadRequest(w, pagination.Fields(err))
+		return
+	}
+
+	changes, meta, err := h.service.ListProfileChanges(r.Context(), id, params)
+	if errors.Is(err, services.ErrUserNotFound) {
+		response.NotFound(w, map[string]string{"id": "User not found"})
+		return
+	}
+	if err != nil {
+		response.InternalError(w, "Failed to retrieve profile changes")
+		return
+	}
+
+	response.SuccessWithMeta(w, changes, meta)
+}
+
 // Delete godoc
 // @Summary      Delete a user
-// @Description  Soft delete a user by ID
+// @Description  Soft delete a user by ID. Requires a recent login (see JWT_FRESH_AUTH_MAX_AGE).
 // @Tags         Users
 // @Produce      json
 // @Security     BearerAuth
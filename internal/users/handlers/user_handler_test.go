@@ -7,18 +7,23 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"go-api-template/internal/users/models"
 	"go-api-template/internal/users/repositories"
 	"go-api-template/internal/users/services"
+	"go-api-template/pkg/middleware"
+	"go-api-template/pkg/pagination"
 
 	"github.com/google/uuid"
 )
 
 // mockDB implements a simple in-memory store for testing
 type mockDB struct {
-	users map[uuid.UUID]*models.User
+	users   map[uuid.UUID]*models.User
+	changes []models.ProfileChange
 }
 
 func newMockDB() *mockDB {
@@ -57,14 +62,14 @@ func (r *mockUserRepository) GetByEmail(_ context.Context, email string) (*model
 	return nil, repositories.ErrUserNotFound
 }
 
-func (r *mockUserRepository) List(_ context.Context, limit, offset int) ([]models.User, error) {
+func (r *mockUserRepository) List(_ context.Context, p pagination.Params) ([]models.User, error) {
 	var result []models.User
 	i := 0
 	for _, user := range r.db.users {
 		if user.DeletedAt != nil {
 			continue
 		}
-		if i >= offset && len(result) < limit {
+		if i >= p.Offset && len(result) < p.FetchLimit() {
 			result = append(result, *user)
 		}
 		i++
@@ -80,6 +85,27 @@ func (r *mockUserRepository) Update(_ context.Context, user *models.User) error
 	return nil
 }
 
+func (r *mockUserRepository) UpdateProfile(ctx context.Context, user *models.User, changes []models.ProfileChange) error {
+	if err := r.Update(ctx, user); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		change.UserID = user.ID
+		r.db.changes = append(r.db.changes, change)
+	}
+	return nil
+}
+
+func (r *mockUserRepository) ListProfileChanges(_ context.Context, userID uuid.UUID, p pagination.Params) ([]models.ProfileChange, error) {
+	var result []models.ProfileChange
+	for _, change := range r.db.changes {
+		if change.UserID == userID && len(result) < p.FetchLimit() {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
 func (r *mockUserRepository) Delete(_ context.Context, id uuid.UUID) error {
 	user, ok := r.db.users[id]
 	if !ok || user.DeletedAt != nil {
@@ -123,7 +149,7 @@ func (s *mockUserService) List(ctx context.Context, limit, offset int) ([]models
 	if limit <= 0 {
 		limit = 20
 	}
-	return s.repo.List(ctx, limit, offset)
+	return s.repo.List(ctx, pagination.Params{Limit: limit, Offset: offset})
 }
 
 func (s *mockUserService) Update(ctx context.Context, id uuid.UUID, req *models.UpdateUserRequest) (*models.User, error) {
@@ -462,3 +488,106 @@ func TestDeleteUser(t *testing.T) {
 		t.Errorf("expected 404 after delete, got %d", w.Code)
 	}
 }
+
+func TestUpdateMe(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		anonymous  bool
+		missing    bool
+		wantStatus int
+		wantField  string // field expected in a fail response
+	}{
+		{name: "updates profile", body: `{"name":"New Name","avatar_url":"https://cdn.example.com/me.png"}`, wantStatus: http.StatusOK},
+		{name: "unauthenticated", body: `{"name":"New Name"}`, anonymous: true, wantStatus: http.StatusUnauthorized, wantField: "auth"},
+		{name: "invalid JSON", body: `{"name":`, wantStatus: http.StatusBadRequest, wantField: "body"},
+		{name: "blank name", body: `{"name":"   "}`, wantStatus: http.StatusBadRequest, wantField: "name"},
+		{name: "name too long", body: `{"name":"` + strings.Repeat("a", 256) + `"}`, wantStatus: http.StatusBadRequest, wantField: "name"},
+		{name: "invalid avatar URL", body: `{"avatar_url":"/avatars/me.png"}`, wantStatus: http.StatusBadRequest, wantField: "avatar_url"},
+		{name: "deleted account", body: `{"name":"New Name"}`, missing: true, wantStatus: http.StatusNotFound, wantField: "user"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newMockDB()
+			me := &models.User{ID: uuid.New(), Email: "me@example.com", Name: "Me"}
+			if !tt.missing {
+				db.users[me.ID] = me
+			}
+			handler := NewUserHandler(services.NewUserService(newMockUserRepository(db)))
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/me", strings.NewReader(tt.body))
+			if !tt.anonymous {
+				req = req.WithContext(middleware.WithUser(req.Context(), me.ID, me.Email, time.Now()))
+			}
+			w := httptest.NewRecorder()
+
+			handler.UpdateMe(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+
+			var resp map[string]any
+			_ = json.NewDecoder(w.Body).Decode(&resp)
+			data, _ := resp["data"].(map[string]any)
+			if tt.wantField != "" {
+				if resp["status"] != "fail" || data[tt.wantField] == nil {
+					t.Errorf("expected fail response for %q, got %v", tt.wantField, resp)
+				}
+				return
+			}
+			if data["name"] != "New Name" || data["avatar_url"] != "https://cdn.example.com/me.png" {
+				t.Errorf("unexpected profile %v", data)
+			}
+		})
+	}
+}
+
+func TestListProfileChanges(t *testing.T) {
+	db := newMockDB()
+	me := &models.User{ID: uuid.New(), Email: "me@example.com", Name: "Me"}
+	db.users[me.ID] = me
+	handler := NewUserHandler(services.NewUserService(newMockUserRepository(db)))
+
+	update := httptest.NewRequest(http.MethodPatch, "/users/me", strings.NewReader(`{"name":"New Name"}`))
+	update = update.WithContext(middleware.WithUser(update.Context(), me.ID, me.Email, time.Now()))
+	handler.UpdateMe(httptest.NewRecorder(), update)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{id}/profile-changes", handler.ListProfileChanges)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantLen    int
+	}{
+		{name: "lists changes", path: "/users/" + me.ID.String() + "/profile-changes", wantStatus: http.StatusOK, wantLen: 1},
+		{name: "unknown user", path: "/users/" + uuid.New().String() + "/profile-changes", wantStatus: http.StatusNotFound},
+		{name: "invalid UUID", path: "/users/not-a-uuid/profile-changes", wantStatus: http.StatusBadRequest},
+		{name: "invalid sort", path: "/users/" + me.ID.String() + "/profile-changes?sort=name", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Data []models.ProfileChange `json:"data"`
+			}
+			_ = json.NewDecoder(w.Body).Decode(&resp)
+			if len(resp.Data) != tt.wantLen || resp.Data[0].Field != models.ProfileFieldName || *resp.Data[0].NewValue != "New Name" {
+				t.Errorf("unexpected changes %+v", resp.Data)
+			}
+		})
+	}
+}
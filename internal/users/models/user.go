@@ -4,18 +4,37 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"go-api-template/pkg/pagination"
 )
 
 // User represents a user in the system
 type User struct {
 	ID        uuid.UUID  `json:"id" db:"id"`
 	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	AvatarURL *string    `json:"avatar_url,omitempty" db:"avatar_url"`
 	CreatedAt time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
 	Email     string     `json:"email" db:"email"`
 	Name      string     `json:"name" db:"name"`
 }
 
+// Profile fields recorded in the change history
+const (
+	ProfileFieldName      = "name"
+	ProfileFieldAvatarURL = "avatar_url"
+)
+
+// ProfileChange records one field changed through PATCH /users/me, kept as history for support
+type ProfileChange struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID    uuid.UUID `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OldValue  *string   `json:"old_value" example:"John Doe"`
+	NewValue  *string   `json:"new_value" example:"Johnny Doe"`
+	CreatedAt time.Time `json:"created_at"`
+	Field     string    `json:"field" example:"name"`
+}
+
 // CreateUserRequest represents the request body for creating a user
 type CreateUserRequest struct {
 	Email string `json:"email"`
@@ -28,6 +47,13 @@ type UpdateUserRequest struct {
 	Name  string `json:"name,omitempty"`
 }
 
+// UpdateProfileRequest represents the request body for updating the current user's profile.
+// Omitted fields are left unchanged; an empty avatar_url removes the avatar.
+type UpdateProfileRequest struct {
+	Name      *string `json:"name,omitempty" example:"John Doe"`
+	AvatarURL *string `json:"avatar_url,omitempty" example:"https://cdn.example.com/avatars/john.png"`
+}
+
 // UserResponse represents a successful user response (JSend format)
 type UserResponse struct {
 	Status string `json:"status" example:"success"`
@@ -36,6 +62,14 @@ type UserResponse struct {
 
 // UsersListResponse represents a successful list of users response
 type UsersListResponse struct {
-	Status string `json:"status" example:"success"`
-	Data   []User `json:"data"`
+	Status string          `json:"status" example:"success"`
+	Data   []User          `json:"data"`
+	Meta   pagination.Meta `json:"meta"`
+}
+
+// ProfileChangesListResponse represents a successful list of profile changes response
+type ProfileChangesListResponse struct {
+	Status string          `json:"status" example:"success"`
+	Data   []ProfileChange `json:"data"`
+	Meta   pagination.Meta `json:"meta"`
 }
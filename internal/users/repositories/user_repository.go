@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	"go-api-template/internal/users/models"
+	"go-api-template/pkg/pagination"
 )
 
 var (
@@ -17,12 +18,13 @@ var (
 
 // UserRepository handles database operations for users
 type UserRepository struct {
-	db *sql.DB
+	db     *sql.DB
+	reader *sql.DB // read replica (or primary) for listings
 }
 
 // NewUserRepository creates a new user repository
-func NewUserRepository(db *sql.DB) *UserRepository {
-	return &UserRepository{db: db}
+func NewUserRepository(db, reader *sql.DB) *UserRepository {
+	return &UserRepository{db: db, reader: reader}
 }
 
 // Create inserts a new user into the database
@@ -49,7 +51,7 @@ func (r *UserRepository) Create(ctx context.Context, user *models.User) error {
 // GetByID retrieves a user by ID
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	query := `
-		SELECT id, email, name, created_at, updated_at
+		SELECT id, email, name, avatar_url, created_at, updated_at
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL`
 
@@ -58,6 +60,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Use
 		&user.ID,
 		&user.Email,
 		&user.Name,
+		&user.AvatarURL,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -75,7 +78,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Use
 // GetByEmail retrieves a user by email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	query := `
-		SELECT id, email, name, created_at, updated_at
+		SELECT id, email, name, avatar_url, created_at, updated_at
 		FROM users
 		WHERE email = $1 AND deleted_at IS NULL`
 
@@ -84,6 +87,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.
 		&user.ID,
 		&user.Email,
 		&user.Name,
+		&user.AvatarURL,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -98,16 +102,34 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.
 	return user, nil
 }
 
-// List retrieves all users with pagination
-func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]models.User, error) {
+// userSortColumns maps sort fields to columns. Only these values are ever
+// interpolated into ORDER BY.
+var userSortColumns = map[string]string{
+	"created_at": "created_at",
+	"name":       "name",
+	"email":      "email",
+}
+
+// List retrieves users with pagination, fetching p.FetchLimit() rows
+func (r *UserRepository) List(ctx context.Context, p pagination.Params) ([]models.User, error) {
+	column, ok := userSortColumns[p.Sort]
+	if !ok {
+		column = "created_at"
+	}
+	direction := "DESC"
+	if p.Order == pagination.OrderAsc {
+		direction = "ASC"
+	}
+
+	// id breaks ties so pages are stable
 	query := `
-		SELECT id, email, name, created_at, updated_at
+		SELECT id, email, name, avatar_url, created_at, updated_at
 		FROM users
 		WHERE deleted_at IS NULL
-		ORDER BY created_at DESC
+		ORDER BY ` + column + ` ` + direction + `, id ` + direction + `
 		LIMIT $1 OFFSET $2`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.reader.QueryContext(ctx, query, p.FetchLimit(), p.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +142,7 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]models.
 			&user.ID,
 			&user.Email,
 			&user.Name,
+			&user.AvatarURL,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -140,14 +163,15 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]models.
 func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	query := `
 		UPDATE users
-		SET email = $1, name = $2, updated_at = $3
-		WHERE id = $4 AND deleted_at IS NULL
+		SET email = $1, name = $2, avatar_url = $3, updated_at = $4
+		WHERE id = $5 AND deleted_at IS NULL
 		RETURNING updated_at`
 
 	now := time.Now().UTC()
 	err := r.db.QueryRowContext(ctx, query,
 		user.Email,
 		user.Name,
+		user.AvatarURL,
 		now,
 		user.ID,
 	).Scan(&user.UpdatedAt)
@@ -159,6 +183,106 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
 	return err
 }
 
+// UpdateProfile updates a user's profile fields and records the changes in one transaction
+func (r *UserRepository) UpdateProfile(ctx context.Context, user *models.User, changes []models.ProfileChange) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // Rollback after Commit is a no-op
+
+	query := `
+		UPDATE users
+		SET name = $1, avatar_url = $2, updated_at = $3
+		WHERE id = $4 AND deleted_at IS NULL
+		RETURNING updated_at`
+
+	now := time.Now().UTC()
+	err = tx.QueryRowContext(ctx, query,
+		user.Name,
+		user.AvatarURL,
+		now,
+		user.ID,
+	).Scan(&user.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO profile_changes (id, user_id, field, old_value, new_value, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for i := range changes {
+		change := &changes[i]
+		change.ID = uuid.New()
+		change.UserID = user.ID
+		change.CreatedAt = now
+
+		_, err := tx.ExecContext(ctx, insert,
+			change.ID,
+			change.UserID,
+			change.Field,
+			change.OldValue,
+			change.NewValue,
+			change.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListProfileChanges retrieves a user's profile changes by creation time with pagination,
+// fetching p.FetchLimit() rows
+func (r *UserRepository) ListProfileChanges(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.ProfileChange, error) {
+	direction := "DESC"
+	if p.Order == pagination.OrderAsc {
+		direction = "ASC"
+	}
+
+	// id breaks ties so pages are stable
+	query := `
+		SELECT id, user_id, field, old_value, new_value, created_at
+		FROM profile_changes
+		WHERE user_id = $1
+		ORDER BY created_at ` + direction + `, id ` + direction + `
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.reader.QueryContext(ctx, query, userID, p.FetchLimit(), p.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck // rows.Close() error is not critical
+
+	var changes []models.ProfileChange
+	for rows.Next() {
+		var change models.ProfileChange
+		err := rows.Scan(
+			&change.ID,
+			&change.UserID,
+			&change.Field,
+			&change.OldValue,
+			&change.NewValue,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // Delete performs a soft delete on a user
 func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `
@@ -1,26 +1,33 @@
 package users
 
 import (
-	"database/sql"
 	"net/http"
+	"time"
 
+	"go-api-template/database"
 	"go-api-template/internal/auth/services"
 	"go-api-template/internal/users/handlers"
 	"go-api-template/internal/users/repositories"
 	userservices "go-api-template/internal/users/services"
+	"go-api-template/pkg/config"
 	"go-api-template/pkg/middleware"
 )
 
 // RegisterRoutes registers all user routes (protected with auth)
-func RegisterRoutes(mux *http.ServeMux, db *sql.DB, jwtService *services.JWTService) {
-	repo := repositories.NewUserRepository(db)
+func RegisterRoutes(mux *http.ServeMux, db *database.DB, jwtService *services.JWTService, cfg *config.Config) {
+	repo := repositories.NewUserRepository(db.Writer(), db.Reader())
 	service := userservices.NewUserService(repo)
 	handler := handlers.NewUserHandler(service)
 
+	// Changing another account's email or deleting it requires a recent login
+	freshAuthMaxAge := time.Duration(cfg.JWT.FreshAuthMaxAge) * time.Minute
+
 	// All user routes require authentication
 	mux.HandleFunc("GET /users", middleware.RequireAuth(jwtService, handler.List))
 	mux.HandleFunc("GET /users/{id}", middleware.RequireAuth(jwtService, handler.GetByID))
+	mux.HandleFunc("GET /users/{id}/profile-changes", middleware.RequireAuth(jwtService, handler.ListProfileChanges))
 	mux.HandleFunc("POST /users", middleware.RequireAuth(jwtService, handler.Create))
-	mux.HandleFunc("PATCH /users/{id}", middleware.RequireAuth(jwtService, handler.Update))
-	mux.HandleFunc("DELETE /users/{id}", middleware.RequireAuth(jwtService, handler.Delete))
+	mux.HandleFunc("PATCH /users/me", middleware.RequireAuth(jwtService, handler.UpdateMe))
+	mux.HandleFunc("PATCH /users/{id}", middleware.RequireAuth(jwtService, middleware.RequireFreshAuth(freshAuthMaxAge, handler.Update)))
+	mux.HandleFunc("DELETE /users/{id}", middleware.RequireAuth(jwtService, middleware.RequireFreshAuth(freshAuthMaxAge, handler.Delete)))
 }
@@ -3,25 +3,58 @@ package services
 import (
 	"context"
 	"errors"
+	"net/url"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
 	"go-api-template/internal/users/models"
 	"go-api-template/internal/users/repositories"
+	"go-api-template/pkg/pagination"
 )
 
 var (
 	ErrEmailAlreadyExists = errors.New("email already exists")
 	ErrUserNotFound       = errors.New("user not found")
+	ErrNameRequired       = errors.New("name is required")
+	ErrNameTooLong        = errors.New("name must be at most 255 characters")
+	ErrInvalidAvatarURL   = errors.New("avatar URL must be an absolute http(s) URL")
 )
 
+// Maximum lengths, matching the users column sizes
+const (
+	maxNameLength      = 255
+	maxAvatarURLLength = 2048
+)
+
+// UserSortFields are the fields GET /users can be sorted by
+var UserSortFields = []string{"created_at", "name", "email"}
+
+// ProfileChangeSortFields are the fields GET /users/{id}/profile-changes can be sorted by
+var ProfileChangeSortFields = []string{"created_at"}
+
+// UserRepository stores users and their profile change history
+type UserRepository interface {
+	Create(ctx context.Context, user *models.User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	List(ctx context.Context, p pagination.Params) ([]models.User, error)
+	Update(ctx context.Context, user *models.User) error
+	UpdateProfile(ctx context.Context, user *models.User, changes []models.ProfileChange) error
+	ListProfileChanges(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.ProfileChange, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+var _ UserRepository = (*repositories.UserRepository)(nil)
+
 // UserService handles business logic for users
 type UserService struct {
-	repo *repositories.UserRepository
+	repo UserRepository
 }
 
 // NewUserService creates a new user service
-func NewUserService(repo *repositories.UserRepository) *UserService {
+func NewUserService(repo UserRepository) *UserService {
 	return &UserService{repo: repo}
 }
 
@@ -57,19 +90,15 @@ func (s *UserService) GetByID(ctx context.Context, id uuid.UUID) (*models.User,
 	return user, err
 }
 
-// List retrieves all users with pagination
-func (s *UserService) List(ctx context.Context, limit, offset int) ([]models.User, error) {
-	if limit <= 0 {
-		limit = 20
-	}
-	if limit > 100 {
-		limit = 100
-	}
-	if offset < 0 {
-		offset = 0
+// List retrieves a page of users. Params are validated by pagination.Parse.
+func (s *UserService) List(ctx context.Context, p pagination.Params) ([]models.User, pagination.Meta, error) {
+	users, err := s.repo.List(ctx, p)
+	if err != nil {
+		return nil, pagination.Meta{}, err
 	}
 
-	return s.repo.List(ctx, limit, offset)
+	users, meta := pagination.Page(users, p)
+	return users, meta, nil
 }
 
 // Update updates a user's information
@@ -105,6 +134,87 @@ func (s *UserService) Update(ctx context.Context, id uuid.UUID, req *models.Upda
 	return user, nil
 }
 
+// UpdateProfile updates the profile fields a user is allowed to change on their own account
+func (s *UserService) UpdateProfile(ctx context.Context, id uuid.UUID, req *models.UpdateProfileRequest) (*models.User, error) {
+	if err := validateProfileUpdate(req); err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetByID(ctx, id)
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []models.ProfileChange
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name != user.Name {
+			changes = append(changes, profileChange(models.ProfileFieldName, &user.Name, &name))
+		}
+		user.Name = name
+	}
+
+	if req.AvatarURL != nil {
+		var avatarURL *string
+		if *req.AvatarURL != "" {
+			v := *req.AvatarURL
+			avatarURL = &v
+		}
+		if !equalOptional(user.AvatarURL, avatarURL) {
+			changes = append(changes, profileChange(models.ProfileFieldAvatarURL, user.AvatarURL, avatarURL))
+		}
+		user.AvatarURL = avatarURL
+	}
+
+	if err := s.repo.UpdateProfile(ctx, user, changes); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListProfileChanges retrieves a page of a user's profile change history.
+// Params are validated by pagination.Parse.
+func (s *UserService) ListProfileChanges(ctx context.Context, userID uuid.UUID, p pagination.Params) ([]models.ProfileChange, pagination.Meta, error) {
+	if _, err := s.GetByID(ctx, userID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	changes, err := s.repo.ListProfileChanges(ctx, userID, p)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	changes, meta := pagination.Page(changes, p)
+	return changes, meta, nil
+}
+
+// profileChange builds a history entry, copying the values so later edits can't alter it
+func profileChange(field string, oldValue, newValue *string) models.ProfileChange {
+	change := models.ProfileChange{Field: field}
+	if oldValue != nil {
+		v := *oldValue
+		change.OldValue = &v
+	}
+	if newValue != nil {
+		v := *newValue
+		change.NewValue = &v
+	}
+	return change
+}
+
+// equalOptional reports whether two optional values are both unset or equal
+func equalOptional(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // Delete soft deletes a user
 func (s *UserService) Delete(ctx context.Context, id uuid.UUID) error {
 	err := s.repo.Delete(ctx, id)
@@ -113,3 +223,28 @@ func (s *UserService) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return err
 }
+
+// validateProfileUpdate validates profile update input
+func validateProfileUpdate(req *models.UpdateProfileRequest) error {
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return ErrNameRequired
+		}
+		if utf8.RuneCountInString(name) > maxNameLength {
+			return ErrNameTooLong
+		}
+	}
+
+	if req.AvatarURL != nil && *req.AvatarURL != "" {
+		if len(*req.AvatarURL) > maxAvatarURLLength {
+			return ErrInvalidAvatarURL
+		}
+		u, err := url.Parse(*req.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidAvatarURL
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,357 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/users/models"
+	"go-api-template/internal/users/repositories"
+	"go-api-template/pkg/pagination"
+)
+
+// fakeUserRepository hands out copies of its users, like a real database would,
+// so tests can tell whether the service actually saved its edits
+type fakeUserRepository struct {
+	users map[uuid.UUID]*models.User
+	err   error
+
+	changes    []models.ProfileChange
+	lastParams pagination.Params
+}
+
+func newFakeUserRepository(users ...*models.User) *fakeUserRepository {
+	repo := &fakeUserRepository{users: make(map[uuid.UUID]*models.User)}
+	for _, u := range users {
+		repo.users[u.ID] = u
+	}
+	return repo
+}
+
+func (r *fakeUserRepository) Create(_ context.Context, user *models.User) error {
+	if r.err != nil {
+		return r.err
+	}
+	user.ID = uuid.New()
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) GetByID(_ context.Context, id uuid.UUID) (*models.User, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	user, ok := r.users[id]
+	if !ok {
+		return nil, repositories.ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepository) GetByEmail(_ context.Context, email string) (*models.User, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, user := range r.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, repositories.ErrUserNotFound
+}
+
+func (r *fakeUserRepository) List(_ context.Context, p pagination.Params) ([]models.User, error) {
+	r.lastParams = p
+	if r.err != nil {
+		return nil, r.err
+	}
+	var users []models.User
+	for _, user := range r.users {
+		if len(users) == p.FetchLimit() {
+			break
+		}
+		users = append(users, *user)
+	}
+	return users, nil
+}
+
+func (r *fakeUserRepository) Update(_ context.Context, user *models.User) error {
+	if r.err != nil {
+		return r.err
+	}
+	if _, ok := r.users[user.ID]; !ok {
+		return repositories.ErrUserNotFound
+	}
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *fakeUserRepository) UpdateProfile(ctx context.Context, user *models.User, changes []models.ProfileChange) error {
+	if err := r.Update(ctx, user); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		change.UserID = user.ID
+		r.changes = append(r.changes, change)
+	}
+	return nil
+}
+
+func (r *fakeUserRepository) ListProfileChanges(_ context.Context, userID uuid.UUID, p pagination.Params) ([]models.ProfileChange, error) {
+	r.lastParams = p
+	if r.err != nil {
+		return nil, r.err
+	}
+	var changes []models.ProfileChange
+	for _, change := range r.changes {
+		if change.UserID == userID && len(changes) < p.FetchLimit() {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+func (r *fakeUserRepository) Delete(_ context.Context, id uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+	if _, ok := r.users[id]; !ok {
+		return repositories.ErrUserNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestUserService_Create(t *testing.T) {
+	existing := &models.User{ID: uuid.New(), Email: "taken@example.com", Name: "Taken"}
+	errDB := errors.New("connection reset")
+
+	tests := []struct {
+		name    string
+		repoErr error
+		req     models.CreateUserRequest
+		wantErr error
+	}{
+		{name: "creates user", req: models.CreateUserRequest{Email: "new@example.com", Name: "New"}},
+		{name: "duplicate email", req: models.CreateUserRequest{Email: "taken@example.com", Name: "Dup"}, wantErr: ErrEmailAlreadyExists},
+		{name: "repository error", repoErr: errDB, req: models.CreateUserRequest{Email: "new@example.com", Name: "New"}, wantErr: errDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeUserRepository(existing)
+			repo.err = tt.repoErr
+			service := NewUserService(repo)
+
+			user, err := service.Create(context.Background(), &tt.req)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (user.ID == uuid.Nil || user.Email != tt.req.Email) {
+				t.Errorf("expected created user with email %s, got %+v", tt.req.Email, user)
+			}
+		})
+	}
+}
+
+func TestUserService_GetByID_NotFound(t *testing.T) {
+	service := NewUserService(newFakeUserRepository())
+
+	_, err := service.GetByID(context.Background(), uuid.New())
+
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUserService_List_Pagination(t *testing.T) {
+	tests := []struct {
+		name        string
+		users       int
+		params      pagination.Params
+		wantLen     int
+		wantHasMore bool
+	}{
+		{name: "partial page", users: 3, params: pagination.Params{Limit: 5}, wantLen: 3},
+		{name: "exactly one page", users: 5, params: pagination.Params{Limit: 5}, wantLen: 5},
+		{name: "more pages", users: 6, params: pagination.Params{Limit: 5}, wantLen: 5, wantHasMore: true},
+		{name: "empty", users: 0, params: pagination.Params{Limit: 5}, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeUserRepository()
+			for range tt.users {
+				id := uuid.New()
+				repo.users[id] = &models.User{ID: id, Email: id.String() + "@example.com"}
+			}
+			service := NewUserService(repo)
+
+			users, meta, err := service.List(context.Background(), tt.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if repo.lastParams != tt.params {
+				t.Errorf("repository got params %+v, want %+v", repo.lastParams, tt.params)
+			}
+			if users == nil || len(users) != tt.wantLen {
+				t.Errorf("expected %d users, got %v", tt.wantLen, users)
+			}
+			if meta.HasMore != tt.wantHasMore || meta.Limit != tt.params.Limit {
+				t.Errorf("unexpected meta %+v", meta)
+			}
+		})
+	}
+}
+
+func TestUserService_Update(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       models.UpdateUserRequest
+		missing   bool
+		wantErr   error
+		wantEmail string
+		wantName  string
+	}{
+		{name: "updates name only", req: models.UpdateUserRequest{Name: "Renamed"}, wantEmail: "john@example.com", wantName: "Renamed"},
+		{name: "updates email", req: models.UpdateUserRequest{Email: "john.doe@example.com"}, wantEmail: "john.doe@example.com", wantName: "John"},
+		{name: "same email is not a conflict", req: models.UpdateUserRequest{Email: "john@example.com"}, wantEmail: "john@example.com", wantName: "John"},
+		{name: "email taken by another user", req: models.UpdateUserRequest{Email: "jane@example.com"}, wantErr: ErrEmailAlreadyExists},
+		{name: "user not found", req: models.UpdateUserRequest{Name: "Ghost"}, missing: true, wantErr: ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			john := &models.User{ID: uuid.New(), Email: "john@example.com", Name: "John"}
+			jane := &models.User{ID: uuid.New(), Email: "jane@example.com", Name: "Jane"}
+			service := NewUserService(newFakeUserRepository(john, jane))
+
+			id := john.ID
+			if tt.missing {
+				id = uuid.New()
+			}
+
+			user, err := service.Update(context.Background(), id, &tt.req)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user.Email != tt.wantEmail || user.Name != tt.wantName {
+				t.Errorf("expected %s/%s, got %s/%s", tt.wantEmail, tt.wantName, user.Email, user.Name)
+			}
+		})
+	}
+}
+
+func TestUserService_UpdateProfile(t *testing.T) {
+	avatar := "https://cdn.example.com/old.png"
+
+	tests := []struct {
+		name       string
+		req        models.UpdateProfileRequest
+		missing    bool
+		wantErr    error
+		wantName   string
+		wantAvatar *string
+	}{
+		{name: "no changes", req: models.UpdateProfileRequest{}, wantName: "John", wantAvatar: &avatar},
+		{name: "trims name", req: models.UpdateProfileRequest{Name: strPtr("  Johnny  ")}, wantName: "Johnny", wantAvatar: &avatar},
+		{name: "sets avatar", req: models.UpdateProfileRequest{AvatarURL: strPtr("https://cdn.example.com/new.png")}, wantName: "John", wantAvatar: strPtr("https://cdn.example.com/new.png")},
+		{name: "clears avatar", req: models.UpdateProfileRequest{AvatarURL: strPtr("")}, wantName: "John", wantAvatar: nil},
+		{name: "blank name", req: models.UpdateProfileRequest{Name: strPtr("   ")}, wantErr: ErrNameRequired},
+		{name: "name at limit", req: models.UpdateProfileRequest{Name: strPtr(strings.Repeat("é", maxNameLength))}, wantName: strings.Repeat("é", maxNameLength), wantAvatar: &avatar},
+		{name: "name too long", req: models.UpdateProfileRequest{Name: strPtr(strings.Repeat("a", maxNameLength+1))}, wantErr: ErrNameTooLong},
+		{name: "relative avatar URL", req: models.UpdateProfileRequest{AvatarURL: strPtr("/avatars/me.png")}, wantErr: ErrInvalidAvatarURL},
+		{name: "non-http avatar URL", req: models.UpdateProfileRequest{AvatarURL: strPtr("javascript:alert(1)")}, wantErr: ErrInvalidAvatarURL},
+		{name: "avatar URL too long", req: models.UpdateProfileRequest{AvatarURL: strPtr("https://cdn.example.com/" + strings.Repeat("a", maxAvatarURLLength))}, wantErr: ErrInvalidAvatarURL},
+		{name: "user not found", req: models.UpdateProfileRequest{Name: strPtr("Ghost")}, missing: true, wantErr: ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := avatar
+			john := &models.User{ID: uuid.New(), Email: "john@example.com", Name: "John", AvatarURL: &current}
+			service := NewUserService(newFakeUserRepository(john))
+
+			id := john.ID
+			if tt.missing {
+				id = uuid.New()
+			}
+
+			user, err := service.UpdateProfile(context.Background(), id, &tt.req)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user.Name != tt.wantName {
+				t.Errorf("expected name %q, got %q", tt.wantName, user.Name)
+			}
+			switch {
+			case tt.wantAvatar == nil && user.AvatarURL != nil:
+				t.Errorf("expected avatar to be cleared, got %q", *user.AvatarURL)
+			case tt.wantAvatar != nil && (user.AvatarURL == nil || *user.AvatarURL != *tt.wantAvatar):
+				t.Errorf("expected avatar %q, got %v", *tt.wantAvatar, user.AvatarURL)
+			}
+		})
+	}
+}
+
+func TestUserService_Delete_NotFound(t *testing.T) {
+	service := NewUserService(newFakeUserRepository())
+
+	err := service.Delete(context.Background(), uuid.New())
+
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestUserService_UpdateProfile_RecordsChanges(t *testing.T) {
+	avatar := "https://cdn.example.com/old.png"
+	john := &models.User{ID: uuid.New(), Email: "john@example.com", Name: "John", AvatarURL: &avatar}
+	repo := newFakeUserRepository(john)
+	service := NewUserService(repo)
+
+	// The name is unchanged, so only the avatar removal is recorded
+	_, err := service.UpdateProfile(context.Background(), john.ID, &models.UpdateProfileRequest{
+		Name:      strPtr(" John "),
+		AvatarURL: strPtr(""),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changes, meta, err := service.ListProfileChanges(context.Background(), john.ID, pagination.Params{Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || meta.HasMore {
+		t.Fatalf("expected 1 change, got %+v", changes)
+	}
+	change := changes[0]
+	if change.Field != models.ProfileFieldAvatarURL || change.OldValue == nil || *change.OldValue != avatar || change.NewValue != nil {
+		t.Errorf("unexpected change %+v", change)
+	}
+
+	if _, _, err := service.ListProfileChanges(context.Background(), uuid.New(), pagination.Params{Limit: 10}); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("expected ErrUserNotFound for unknown user, got %v", err)
+	}
+}
@@ -0,0 +1,4 @@
+-- 000003_add_avatar_url_to_users.down.sql
+-- Rollback migration: Removes avatar_url column from users table
+
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_url;
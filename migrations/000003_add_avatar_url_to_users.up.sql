@@ -0,0 +1,4 @@
+-- 000003_add_avatar_url_to_users.up.sql
+-- Adds avatar_url column to users table for profile pictures
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_url VARCHAR(2048);
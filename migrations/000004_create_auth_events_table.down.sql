@@ -0,0 +1,5 @@
+-- 000004_create_auth_events_table.down.sql
+-- Rollback migration: Drops the auth_events table and related objects
+
+DROP INDEX IF EXISTS idx_auth_events_user_id_created_at;
+DROP TABLE IF EXISTS auth_events;
@@ -0,0 +1,15 @@
+-- 000004_create_auth_events_table.up.sql
+-- Creates the auth_events table for auditing authentication security events
+
+CREATE TABLE IF NOT EXISTS auth_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a user's events newest first
+CREATE INDEX IF NOT EXISTS idx_auth_events_user_id_created_at ON auth_events(user_id, created_at DESC);
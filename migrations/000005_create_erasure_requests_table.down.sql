@@ -0,0 +1,7 @@
+-- 000005_create_erasure_requests_table.down.sql
+-- Rollback migration: Drops the erasure_requests table and related objects
+
+DROP TRIGGER IF EXISTS update_erasure_requests_updated_at ON erasure_requests;
+DROP INDEX IF EXISTS idx_erasure_requests_status_created_at;
+DROP INDEX IF EXISTS idx_erasure_requests_user_pending;
+DROP TABLE IF EXISTS erasure_requests;
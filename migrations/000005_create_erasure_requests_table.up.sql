@@ -0,0 +1,24 @@
+-- 000005_create_erasure_requests_table.up.sql
+-- Creates the erasure_requests table for user data deletion requests awaiting review
+
+CREATE TABLE IF NOT EXISTS erasure_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reason TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- At most one pending request per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_erasure_requests_user_pending ON erasure_requests(user_id) WHERE status = 'pending';
+
+-- Index for the review queue (oldest pending first)
+CREATE INDEX IF NOT EXISTS idx_erasure_requests_status_created_at ON erasure_requests(status, created_at);
+
+-- Trigger to auto-update updated_at timestamp (function created in 000001)
+CREATE TRIGGER update_erasure_requests_updated_at
+    BEFORE UPDATE ON erasure_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- 000006_create_profile_changes_table.down.sql
+-- Rollback migration: Drops the profile_changes table and related objects
+
+DROP INDEX IF EXISTS idx_profile_changes_user_id_created_at;
+DROP TABLE IF EXISTS profile_changes;
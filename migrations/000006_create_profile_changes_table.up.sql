@@ -0,0 +1,14 @@
+-- 000006_create_profile_changes_table.up.sql
+-- Creates the profile_changes table, a history of self-service profile edits for support
+
+CREATE TABLE IF NOT EXISTS profile_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    field VARCHAR(50) NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Index for listing a user's changes newest first
+CREATE INDEX IF NOT EXISTS idx_profile_changes_user_id_created_at ON profile_changes(user_id, created_at DESC);
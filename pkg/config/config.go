@@ -3,6 +3,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -28,6 +29,9 @@ type Config struct {
 
 	// JWT configuration
 	JWT JWTConfig
+
+	// FeatureFlags is the list of feature flag specs (see pkg/featureflags)
+	FeatureFlags []string
 }
 
 // ServerConfig holds HTTP server configuration
@@ -49,6 +53,16 @@ type ServerConfig struct {
 
 	// ShutdownTimeout is the maximum duration to wait for active connections to close
 	ShutdownTimeout time.Duration
+
+	// DebugAddr is the internal-only address for pprof/expvar endpoints (empty disables them)
+	DebugAddr string
+
+	// TrustedProxies lists the IPs or CIDRs of reverse proxies whose X-Forwarded-For
+	// and X-Real-IP headers are trusted for client IPs (empty trusts none)
+	TrustedProxies []string
+
+	// DevRoutesEnabled mounts development-only routes such as /test/* (default: off in production)
+	DevRoutesEnabled bool
 }
 
 // DatabaseConfig holds database connection configuration
@@ -56,6 +70,9 @@ type DatabaseConfig struct {
 	// URL is the full database connection string (takes precedence if set)
 	URL string
 
+	// ReplicaURL is the full connection string of an optional read replica
+	ReplicaURL string
+
 	// Host is the database server hostname
 	Host string
 
@@ -82,6 +99,12 @@ type DatabaseConfig struct {
 
 	// ConnMaxLifetime is the maximum lifetime of a connection
 	ConnMaxLifetime time.Duration
+
+	// HealthCheckInterval is how often connectivity is checked while healthy
+	HealthCheckInterval time.Duration
+
+	// ReconnectMaxBackoff caps the exponential backoff between reconnect attempts
+	ReconnectMaxBackoff time.Duration
 }
 
 // CORSConfig holds CORS middleware configuration
@@ -136,6 +159,10 @@ type JWTConfig struct {
 
 	// RefreshTokenTTL is the refresh token time-to-live in hours
 	RefreshTokenTTL int
+
+	// FreshAuthMaxAge is how long after login sensitive operations are allowed, in minutes.
+	// Values below one would lock every fresh-auth route, so they fall back to the default.
+	FreshAuthMaxAge int
 }
 
 // Load loads configuration from environment variables with defaults.
@@ -148,18 +175,24 @@ func Load() *Config {
 			IdleTimeout:       getDurationEnv("SERVER_IDLE_TIMEOUT", 60*time.Second),
 			ReadHeaderTimeout: getDurationEnv("SERVER_READ_HEADER_TIMEOUT", 5*time.Second),
 			ShutdownTimeout:   getDurationEnv("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
+			DebugAddr:         getEnv("DEBUG_ADDR", ""),
+			TrustedProxies:    getSliceEnv("TRUSTED_PROXIES", nil),
+			DevRoutesEnabled:  getBoolEnv("DEV_ROUTES_ENABLED", !isProductionEnv()),
 		},
 		Database: DatabaseConfig{
-			URL:             getEnv("DATABASE_URL", ""),
-			Host:            getEnv("DB_HOST", "localhost"),
-			Port:            getEnv("DB_PORT", "5433"),
-			User:            getEnv("DB_USER", "postgres"),
-			Password:        getEnv("DB_PASSWORD", "postgres"),
-			Name:            getEnv("DB_NAME", "app_db"),
-			SSLMode:         getEnv("DB_SSLMODE", "disable"),
-			MaxOpenConns:    getIntEnv("DB_MAX_OPEN_CONNS", 25),
-			MaxIdleConns:    getIntEnv("DB_MAX_IDLE_CONNS", 5),
-			ConnMaxLifetime: getDurationEnv("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			URL:                 getEnv("DATABASE_URL", ""),
+			ReplicaURL:          getEnv("DATABASE_REPLICA_URL", ""),
+			Host:                getEnv("DB_HOST", "localhost"),
+			Port:                getEnv("DB_PORT", "5433"),
+			User:                getEnv("DB_USER", "postgres"),
+			Password:            getEnv("DB_PASSWORD", "postgres"),
+			Name:                getEnv("DB_NAME", "app_db"),
+			SSLMode:             getEnv("DB_SSLMODE", "disable"),
+			MaxOpenConns:        getIntEnv("DB_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:        getIntEnv("DB_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime:     getDurationEnv("DB_CONN_MAX_LIFETIME", 5*time.Minute),
+			HealthCheckInterval: getDurationEnv("DB_HEALTH_CHECK_INTERVAL", 10*time.Second),
+			ReconnectMaxBackoff: getDurationEnv("DB_RECONNECT_MAX_BACKOFF", 30*time.Second),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins:   getSliceEnv("CORS_ALLOWED_ORIGINS", []string{"*"}),
@@ -180,9 +213,11 @@ func Load() *Config {
 		},
 		JWT: JWTConfig{
 			SecretKey:       getEnv("JWT_SECRET_KEY", "your-super-secret-key-change-in-production"),
-			AccessTokenTTL:  getIntEnv("JWT_ACCESS_TOKEN_TTL", 15),  // 15 minutes
-			RefreshTokenTTL: getIntEnv("JWT_REFRESH_TOKEN_TTL", 168), // 7 days (168 hours)
+			AccessTokenTTL:  getIntEnv("JWT_ACCESS_TOKEN_TTL", 15),          // 15 minutes
+			RefreshTokenTTL: getIntEnv("JWT_REFRESH_TOKEN_TTL", 168),        // 7 days (168 hours)
+			FreshAuthMaxAge: getPositiveIntEnv("JWT_FRESH_AUTH_MAX_AGE", 5), // 5 minutes
 		},
+		FeatureFlags: getSliceEnv("FEATURE_FLAGS", nil),
 	}
 }
 
@@ -204,6 +239,14 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getPositiveIntEnv is like getIntEnv but also returns the default for zero or negative values
+func getPositiveIntEnv(key string, defaultValue int) int {
+	if value := getIntEnv(key, defaultValue); value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
 // getBoolEnv gets a boolean environment variable or returns a default value
 func getBoolEnv(key string, defaultValue bool) bool {
 	if value := os.Getenv(key); value != "" {
@@ -251,6 +294,23 @@ func (c *Config) IsDevelopment() bool {
 
 // IsProduction returns true if running in production mode
 func (c *Config) IsProduction() bool {
+	return isProductionEnv()
+}
+
+// isProductionEnv returns true if APP_ENV is set to production
+func isProductionEnv() bool {
 	env := getEnv("APP_ENV", "development")
 	return env == "production" || env == "prod"
 }
+
+// DSN returns the primary database connection string.
+// URL is used as-is when set; otherwise it is built from the individual fields.
+func (c DatabaseConfig) DSN() string {
+	if c.URL != "" {
+		return c.URL
+	}
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Port, c.User, c.Password, c.Name, c.SSLMode,
+	)
+}
@@ -0,0 +1,27 @@
+package config
+
+import "testing"
+
+func TestLoad_FreshAuthMaxAge(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "", want: 5},
+		{value: "10", want: 10},
+		{value: "1", want: 1},
+		{value: "0", want: 5},
+		{value: "-3", want: 5},
+		{value: "soon", want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("JWT_FRESH_AUTH_MAX_AGE", tt.value)
+
+			if got := Load().JWT.FreshAuthMaxAge; got != tt.want {
+				t.Errorf("FreshAuthMaxAge = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
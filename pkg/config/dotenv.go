@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// DotEnv loads a .env file into the process environment and keeps track of
+// the variables it set, so it can be loaded again on config reload.
+// Variables set in the real environment always take precedence over the file.
+type DotEnv struct {
+	path        string
+	processKeys map[string]bool // set before the file was first loaded
+	applied     map[string]bool // set from the file by the last Load
+}
+
+// NewDotEnv snapshots the current environment. Create it before the first Load.
+func NewDotEnv(path string) *DotEnv {
+	processKeys := make(map[string]bool)
+	for _, kv := range os.Environ() {
+		key, _, _ := strings.Cut(kv, "=")
+		processKeys[key] = true
+	}
+
+	return &DotEnv{
+		path:        path,
+		processKeys: processKeys,
+		applied:     make(map[string]bool),
+	}
+}
+
+// Load applies the file's values for keys not set in the real environment and
+// unsets keys that a previous Load applied but the file no longer contains, so
+// they fall back to their defaults. A missing file counts as empty.
+func (d *DotEnv) Load() error {
+	values, err := godotenv.Read(d.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		values, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for key := range d.applied {
+		if _, ok := values[key]; ok {
+			continue
+		}
+		if err := os.Unsetenv(key); err != nil {
+			return err
+		}
+		delete(d.applied, key)
+	}
+
+	for key, value := range values {
+		if d.processKeys[key] {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+		d.applied[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDotEnv_Load(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	writeEnv := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write .env: %v", err)
+		}
+	}
+
+	// t.Setenv restores both variables after the test, including ones Load sets
+	t.Setenv("DOTENV_TEST_PROCESS", "from-process")
+	t.Setenv("DOTENV_TEST_FILE", "")
+	if err := os.Unsetenv("DOTENV_TEST_FILE"); err != nil {
+		t.Fatal(err)
+	}
+
+	env := NewDotEnv(path)
+
+	writeEnv("DOTENV_TEST_PROCESS=from-file\nDOTENV_TEST_FILE=v1\n")
+	if err := env.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := os.Getenv("DOTENV_TEST_PROCESS"); got != "from-process" {
+		t.Errorf("process variable = %q, want it to win over .env", got)
+	}
+	if got := os.Getenv("DOTENV_TEST_FILE"); got != "v1" {
+		t.Errorf("file variable = %q, want v1", got)
+	}
+
+	writeEnv("DOTENV_TEST_PROCESS=from-file\nDOTENV_TEST_FILE=v2\n")
+	if err := env.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := os.Getenv("DOTENV_TEST_FILE"); got != "v2" {
+		t.Errorf("edited file variable = %q, want v2", got)
+	}
+
+	writeEnv("DOTENV_TEST_PROCESS=from-file\n")
+	if err := env.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if _, ok := os.LookupEnv("DOTENV_TEST_FILE"); ok {
+		t.Error("variable removed from .env is still set")
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.Load(); err != nil {
+		t.Errorf("Load() with missing file error = %v", err)
+	}
+	if got := os.Getenv("DOTENV_TEST_PROCESS"); got != "from-process" {
+		t.Errorf("process variable = %q after .env removal, want from-process", got)
+	}
+}
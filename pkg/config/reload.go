@@ -0,0 +1,47 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+)
+
+// reloadableKeys are the settings that take effect on reload without a restart.
+// Everything else is read once at startup.
+var reloadableKeys = []string{
+	"FeatureFlags",
+	"Log.Level",
+	"RateLimit.Rate",
+	"RateLimit.Window",
+}
+
+// IsReloadable reports whether a changed key takes effect without a restart
+func IsReloadable(key string) bool {
+	return slices.Contains(reloadableKeys, key)
+}
+
+// Diff returns the keys of all settings that differ between a and b, as
+// "Section.Field" paths. Values are not returned so secrets never end up in logs.
+func Diff(a, b *Config) []string {
+	var changed []string
+	diffStruct("", reflect.ValueOf(*a), reflect.ValueOf(*b), &changed)
+	return changed
+}
+
+// diffStruct walks nested structs and appends the paths of differing fields
+func diffStruct(prefix string, a, b reflect.Value, changed *[]string) {
+	for i := range a.NumField() {
+		name := a.Type().Field(i).Name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		fa, fb := a.Field(i), b.Field(i)
+		if fa.Kind() == reflect.Struct {
+			diffStruct(name, fa, fb, changed)
+			continue
+		}
+		if !reflect.DeepEqual(fa.Interface(), fb.Interface()) {
+			*changed = append(*changed, name)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := Load()
+	b := *a
+	b.RateLimit.Rate = a.RateLimit.Rate + 1
+	b.JWT.SecretKey = "rotated"
+	b.CORS.AllowedOrigins = []string{"https://example.com"}
+
+	got := Diff(a, &b)
+	want := []string{"CORS.AllowedOrigins", "RateLimit.Rate", "JWT.SecretKey"}
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+
+	if changed := Diff(a, a); len(changed) != 0 {
+		t.Errorf("Diff() of identical configs = %v, want none", changed)
+	}
+}
+
+func TestIsReloadable(t *testing.T) {
+	if !IsReloadable("RateLimit.Window") || IsReloadable("JWT.SecretKey") {
+		t.Error("IsReloadable() returned unexpected result")
+	}
+}
@@ -0,0 +1,125 @@
+// Package diagnostics provides runtime debugging endpoints (pprof, expvar, a
+// goroutine summary and the active feature flags). The handler is meant to be served on an internal-only
+// listener and must never be mounted on the public API router.
+package diagnostics
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"go-api-template/pkg/featureflags"
+	"go-api-template/pkg/response"
+)
+
+// GoroutineGroup is the number of goroutines sharing the same entry function
+type GoroutineGroup struct {
+	Function string `json:"function" example:"go-api-template/pkg/middleware.(*RateLimiter).cleanupLoop"`
+	Count    int    `json:"count" example:"1"`
+}
+
+// GoroutineSummary describes the goroutines currently running in the process
+type GoroutineSummary struct {
+	Groups     []GoroutineGroup `json:"groups"`
+	Total      int              `json:"total" example:"12"`
+	GOMAXPROCS int              `json:"gomaxprocs" example:"8"`
+	NumCPU     int              `json:"num_cpu" example:"8"`
+}
+
+// NewHandler returns a handler exposing:
+//
+//	/debug/pprof/*        - net/http/pprof profiles
+//	/debug/vars           - expvar metrics
+//	/debug/goroutines     - goroutine counts grouped by entry function
+//	/debug/flags          - the active feature flags
+func NewHandler(flags *atomic.Pointer[featureflags.Flags]) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /debug/pprof/", pprof.Index)
+	mux.HandleFunc("GET /debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("GET /debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("GET /debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("POST /debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
+	mux.Handle("GET /debug/vars", expvar.Handler())
+	mux.HandleFunc("GET /debug/goroutines", func(w http.ResponseWriter, _ *http.Request) {
+		response.Success(w, Goroutines())
+	})
+	mux.HandleFunc("GET /debug/flags", func(w http.ResponseWriter, _ *http.Request) {
+		response.Success(w, flags.Load().List())
+	})
+
+	return mux
+}
+
+// Goroutines returns a summary of all goroutines grouped by the function they were started with.
+// Groups are sorted by count (descending) so leaking loops stand out at the top.
+func Goroutines() GoroutineSummary {
+	counts := make(map[string]int)
+	total := 0
+
+	for _, block := range strings.Split(string(allStacks()), "\n\n") {
+		if !strings.HasPrefix(block, "goroutine ") {
+			continue
+		}
+		counts[entryFunction(block)]++
+		total++
+	}
+
+	groups := make([]GoroutineGroup, 0, len(counts))
+	for fn, count := range counts {
+		groups = append(groups, GoroutineGroup{Function: fn, Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Function < groups[j].Function
+	})
+
+	return GoroutineSummary{
+		Groups:     groups,
+		Total:      total,
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		NumCPU:     runtime.NumCPU(),
+	}
+}
+
+// allStacks returns the stack traces of all goroutines, growing the buffer as needed
+func allStacks() []byte {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// entryFunction returns the outermost function of a goroutine stack trace,
+// i.e. the function passed to the go statement that started it.
+func entryFunction(block string) string {
+	entry := "unknown"
+	for _, line := range strings.Split(block, "\n")[1:] {
+		// Skip file:line lines and the "...N frames elided..." marker of deep stacks
+		if line == "" || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "...") {
+			continue
+		}
+		if strings.HasPrefix(line, "created by ") {
+			break
+		}
+		entry = line
+	}
+
+	// Strip call arguments, e.g. "main.worker(0x1, 0x2)" -> "main.worker"
+	if i := strings.LastIndex(entry, "("); i > 0 && strings.HasSuffix(entry, ")") {
+		entry = entry[:i]
+	}
+
+	return entry
+}
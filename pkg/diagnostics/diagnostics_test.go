@@ -0,0 +1,153 @@
+package diagnostics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-api-template/pkg/featureflags"
+)
+
+func TestEntryFunction(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		want  string
+	}{
+		{
+			name: "main goroutine",
+			block: `goroutine 1 [running]:
+main.main()
+	/app/main.go:12 +0x1d`,
+			want: "main.main",
+		},
+		{
+			name: "method receiver",
+			block: `goroutine 7 [select]:
+go-api-template/pkg/middleware.(*RateLimiter).cleanupLoop(0xc0000a2000)
+	/app/pkg/middleware/ratelimit.go:120 +0x85
+created by go-api-template/pkg/middleware.NewRateLimiter in goroutine 1
+	/app/pkg/middleware/ratelimit.go:90 +0x1c5`,
+			want: "go-api-template/pkg/middleware.(*RateLimiter).cleanupLoop",
+		},
+		{
+			name: "closure with inner frames",
+			block: `goroutine 21 [chan receive]:
+main.worker(0xc000012345, {0x1, 0x2})
+	/app/main.go:30 +0x3a
+main.main.func1()
+	/app/main.go:18 +0x25
+created by main.main in goroutine 1
+	/app/main.go:17 +0x4f`,
+			want: "main.main.func1",
+		},
+		{
+			name: "elided frames",
+			block: `goroutine 9 [sleep]:
+time.Sleep(0x3b9aca00)
+	/usr/local/go/src/runtime/time.go:300 +0xf2
+main.deep(0x0?)
+	/app/main.go:5 +0x34
+...52 frames elided...
+main.deep(0x0?)
+	/app/main.go:5 +0x34
+main.deep(0x96)
+	/app/main.go:5 +0x34
+created by main.main in goroutine 1
+	/app/main.go:9 +0x1e`,
+			want: "main.deep",
+		},
+		{
+			name: "trailing elided marker",
+			block: `goroutine 9 [sleep]:
+main.deep(0x0?)
+	/app/main.go:5 +0x34
+main.deep(0x1)
+	/app/main.go:5 +0x34
+...additional frames elided...
+created by main.main
+	/app/main.go:9 +0x1e`,
+			want: "main.deep",
+		},
+		{
+			name: "generic function",
+			block: `goroutine 4 [chan send]:
+main.produce[...](0xc000020060)
+	/app/main.go:40 +0x2b
+created by main.main in goroutine 1
+	/app/main.go:12 +0x3c`,
+			want: "main.produce[...]",
+		},
+		{
+			name:  "no frames",
+			block: `goroutine 3 [running]:`,
+			want:  "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := entryFunction(tt.block); got != tt.want {
+				t.Errorf("entryFunction() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGoroutines(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	for range 3 {
+		go blockUntil(stop)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	summary := Goroutines()
+
+	var found bool
+	for _, g := range summary.Groups {
+		if g.Function == "go-api-template/pkg/diagnostics.blockUntil" {
+			found = g.Count >= 3
+		}
+	}
+	if !found {
+		t.Errorf("expected a group of 3 blockUntil goroutines, got %+v", summary.Groups)
+	}
+	if summary.Total < 4 {
+		t.Errorf("expected at least 4 goroutines, got %d", summary.Total)
+	}
+}
+
+// blockUntil is a named goroutine entry point for TestGoroutines
+func blockUntil(stop <-chan struct{}) {
+	<-stop
+}
+
+func TestFlagsEndpoint_FollowsReload(t *testing.T) {
+	parse := func(specs ...string) *featureflags.Flags {
+		t.Helper()
+		flags, err := featureflags.Parse(specs)
+		if err != nil {
+			t.Fatalf("parse flags: %v", err)
+		}
+		return flags
+	}
+
+	var flags atomic.Pointer[featureflags.Flags]
+	flags.Store(parse("old_flag"))
+	handler := NewHandler(&flags)
+
+	flags.Store(parse("new_flag=25%"))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/flags", nil))
+
+	body := w.Body.String()
+	if w.Code != http.StatusOK || !strings.Contains(body, `"new_flag"`) || strings.Contains(body, `"old_flag"`) {
+		t.Errorf("expected the reloaded flags, got %d %s", w.Code, body)
+	}
+}
@@ -0,0 +1,122 @@
+// Package featureflags provides simple feature toggles with percentage-based rollouts.
+// Flags are configured via the FEATURE_FLAGS environment variable as a comma-separated
+// list of specs, for example:
+//
+//	FEATURE_FLAGS=profile_avatars,new_pricing=25%,legacy_login=off
+//
+// A bare name enables the flag for everyone, "off" disables it, and "N%" enables it
+// for a stable N percent of subjects (e.g. user IDs).
+package featureflags
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFlag = errors.New("invalid feature flag spec")
+)
+
+// Flag represents a single feature flag and its rollout percentage
+type Flag struct {
+	Name       string `json:"name"`
+	Percentage int    `json:"percentage"`
+}
+
+// Flags holds the set of configured feature flags.
+// It is immutable after creation and safe for concurrent use.
+type Flags struct {
+	flags map[string]Flag
+}
+
+// Parse builds a flag set from a list of specs ("name", "name=on", "name=off", "name=25%")
+func Parse(specs []string) (*Flags, error) {
+	f := &Flags{flags: make(map[string]Flag, len(specs))}
+
+	for _, spec := range specs {
+		flag, err := parseSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		f.flags[flag.Name] = flag
+	}
+
+	return f, nil
+}
+
+// parseSpec parses a single flag spec
+func parseSpec(spec string) (Flag, error) {
+	name, value, hasValue := strings.Cut(strings.TrimSpace(spec), "=")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Flag{}, fmt.Errorf("%w: %q has no name", ErrInvalidFlag, spec)
+	}
+
+	if !hasValue {
+		return Flag{Name: name, Percentage: 100}, nil
+	}
+
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "on", "true":
+		return Flag{Name: name, Percentage: 100}, nil
+	case "off", "false":
+		return Flag{Name: name, Percentage: 0}, nil
+	}
+
+	percentage, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || !strings.HasSuffix(value, "%") || percentage < 0 || percentage > 100 {
+		return Flag{}, fmt.Errorf("%w: %q must be on, off or a percentage between 0%% and 100%%", ErrInvalidFlag, spec)
+	}
+
+	return Flag{Name: name, Percentage: percentage}, nil
+}
+
+// Enabled reports whether a flag is fully enabled (rolled out to 100%).
+// Unknown flags are disabled.
+func (f *Flags) Enabled(name string) bool {
+	flag, ok := f.flags[name]
+	return ok && flag.Percentage >= 100
+}
+
+// EnabledFor reports whether a flag is enabled for the given subject (e.g. a user ID).
+// The same subject always lands in the same bucket for a given flag, so partial
+// rollouts are stable across requests and restarts.
+func (f *Flags) EnabledFor(name, subject string) bool {
+	flag, ok := f.flags[name]
+	if !ok || flag.Percentage <= 0 {
+		return false
+	}
+	if flag.Percentage >= 100 {
+		return true
+	}
+
+	return bucket(name, subject) < flag.Percentage
+}
+
+// List returns all configured flags sorted by name
+func (f *Flags) List() []Flag {
+	list := make([]Flag, 0, len(f.flags))
+	for _, flag := range f.flags {
+		list = append(list, flag)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// bucket maps a flag/subject pair to a stable value in [0, 100)
+func bucket(name, subject string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write([]byte{0})
+	h.Write([]byte(subject))
+	return int(h.Sum32() % 100)
+}
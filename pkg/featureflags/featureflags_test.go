@@ -0,0 +1,97 @@
+package featureflags
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       string
+		wantName   string
+		percentage int
+		wantErr    bool
+	}{
+		{name: "bare name", spec: "avatars", wantName: "avatars", percentage: 100},
+		{name: "on", spec: "avatars=on", wantName: "avatars", percentage: 100},
+		{name: "off", spec: "avatars=off", wantName: "avatars", percentage: 0},
+		{name: "percentage", spec: "pricing=25%", wantName: "pricing", percentage: 25},
+		{name: "whitespace", spec: " pricing = 10% ", wantName: "pricing", percentage: 10},
+		{name: "missing name", spec: "=on", wantErr: true},
+		{name: "missing percent sign", spec: "pricing=25", wantErr: true},
+		{name: "out of range", spec: "pricing=150%", wantErr: true},
+		{name: "garbage", spec: "pricing=maybe", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags, err := Parse([]string{tt.spec})
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFlag) {
+					t.Fatalf("expected ErrInvalidFlag, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			list := flags.List()
+			if len(list) != 1 {
+				t.Fatalf("expected 1 flag, got %d", len(list))
+			}
+			if list[0].Name != tt.wantName || list[0].Percentage != tt.percentage {
+				t.Errorf("expected %s=%d%%, got %s=%d%%", tt.wantName, tt.percentage, list[0].Name, list[0].Percentage)
+			}
+		})
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	flags, err := Parse([]string{"full", "none=off", "half=50%"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !flags.Enabled("full") {
+		t.Error("expected full to be enabled")
+	}
+	if flags.Enabled("none") {
+		t.Error("expected none to be disabled")
+	}
+	if flags.Enabled("half") {
+		t.Error("expected partial rollout not to count as fully enabled")
+	}
+	if flags.Enabled("unknown") {
+		t.Error("expected unknown flag to be disabled")
+	}
+}
+
+func TestEnabledFor(t *testing.T) {
+	flags, err := Parse([]string{"half=50%"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	enabled := 0
+	for i := range 1000 {
+		subject := fmt.Sprintf("user-%d", i)
+		first := flags.EnabledFor("half", subject)
+		if first != flags.EnabledFor("half", subject) {
+			t.Fatalf("expected stable result for %s", subject)
+		}
+		if first {
+			enabled++
+		}
+	}
+
+	if enabled < 400 || enabled > 600 {
+		t.Errorf("expected roughly half of subjects enabled, got %d/1000", enabled)
+	}
+
+	if flags.EnabledFor("unknown", "user-1") {
+		t.Error("expected unknown flag to be disabled")
+	}
+}
@@ -2,11 +2,11 @@
 package middleware
 
 import (
-	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
-	"go-api-template/internal/auth/handlers"
 	"go-api-template/internal/auth/services"
 	"go-api-template/pkg/response"
 )
@@ -50,8 +50,7 @@ func AuthMiddleware(jwtService *services.JWTService) func(http.Handler) http.Han
 			}
 
 			// Add user info to context
-			ctx := context.WithValue(r.Context(), handlers.UserIDKey, claims.UserID)
-			ctx = context.WithValue(ctx, handlers.UserEmailKey, claims.Email)
+			ctx := WithUser(r.Context(), claims.UserID, claims.Email, claims.AuthenticatedAt())
 
 			// Call next handler with updated context
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -97,10 +96,29 @@ func RequireAuth(jwtService *services.JWTService, handler http.HandlerFunc) http
 		}
 
 		// Add user info to context
-		ctx := context.WithValue(r.Context(), handlers.UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, handlers.UserEmailKey, claims.Email)
+		ctx := WithUser(r.Context(), claims.UserID, claims.Email, claims.AuthenticatedAt())
 
 		// Call handler with updated context
 		handler(w, r.WithContext(ctx))
 	}
 }
+
+// RequireFreshAuth rejects requests whose user authenticated more than maxAge ago.
+// It must be wrapped by RequireAuth, which puts the authentication time in the context.
+// Clients should prompt for credentials and log in again when they get this 401.
+//
+// Usage: middleware.RequireAuth(jwtService, middleware.RequireFreshAuth(5*time.Minute, handler))
+func RequireFreshAuth(maxAge time.Duration, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authenticatedAt, ok := AuthTime(r.Context())
+		if !ok || time.Since(authenticatedAt) > maxAge {
+			// RFC 9470 step-up authentication challenge
+			w.Header().Set("WWW-Authenticate",
+				fmt.Sprintf(`Bearer error="insufficient_user_authentication", max_age=%d`, int(maxAge.Seconds())))
+			response.Unauthorized(w, map[string]string{"auth": "Recent authentication required, please log in again"})
+			return
+		}
+
+		handler(w, r)
+	}
+}
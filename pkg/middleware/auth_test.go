@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-api-template/internal/auth/services"
+)
+
+func TestRequireFreshAuth(t *testing.T) {
+	jwtService := services.NewJWTService("test-secret", 15*time.Minute, time.Hour)
+	maxAge := 5 * time.Minute
+
+	handler := RequireAuth(jwtService, RequireFreshAuth(maxAge, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name       string
+		authTime   time.Time
+		wantStatus int
+	}{
+		{name: "fresh login", authTime: time.Now(), wantStatus: http.StatusNoContent},
+		{name: "just within max age", authTime: time.Now().Add(-maxAge + time.Minute), wantStatus: http.StatusNoContent},
+		{name: "stale login", authTime: time.Now().Add(-maxAge - time.Minute), wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := jwtService.GenerateTokenPair(uuid.New(), "user@example.com", tt.authTime)
+			if err != nil {
+				t.Fatalf("GenerateTokenPair() error = %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/123", nil)
+			req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+			w := httptest.NewRecorder()
+
+			handler(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			challenge := w.Header().Get("WWW-Authenticate")
+			if tt.wantStatus == http.StatusUnauthorized && !strings.Contains(challenge, "insufficient_user_authentication") {
+				t.Errorf("expected step-up challenge, got %q", challenge)
+			}
+		})
+	}
+}
+
+func TestRequireFreshAuth_WithoutRequireAuth(t *testing.T) {
+	handler := RequireFreshAuth(time.Minute, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodDelete, "/users/123", nil))
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", w.Code)
+	}
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Context keys for the authenticated user, set by the auth middleware.
+// Read them with the UserID, UserEmail and AuthTime helpers.
+const (
+	// UserIDKey is the context key for the user ID (uuid.UUID)
+	UserIDKey contextKey = "user_id"
+	// UserEmailKey is the context key for the user email (string)
+	UserEmailKey contextKey = "user_email"
+	// AuthTimeKey is the context key for the time the user last authenticated (time.Time)
+	AuthTimeKey contextKey = "auth_time"
+)
+
+// WithUser returns a copy of ctx carrying the authenticated user.
+// The auth middleware calls this; tests can use it to simulate an authenticated request.
+func WithUser(ctx context.Context, userID uuid.UUID, email string, authTime time.Time) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, userID)
+	ctx = context.WithValue(ctx, UserEmailKey, email)
+	return context.WithValue(ctx, AuthTimeKey, authTime)
+}
+
+// UserID returns the authenticated user's ID, or false if the request is not authenticated
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(UserIDKey).(uuid.UUID)
+	return userID, ok
+}
+
+// UserEmail returns the authenticated user's email, or false if the request is not authenticated
+func UserEmail(ctx context.Context) (string, bool) {
+	email, ok := ctx.Value(UserEmailKey).(string)
+	return email, ok
+}
+
+// AuthTime returns when the authenticated user last logged in with credentials,
+// or false if the request is not authenticated
+func AuthTime(ctx context.Context) (time.Time, bool) {
+	authTime, ok := ctx.Value(AuthTimeKey).(time.Time)
+	return authTime, ok
+}
@@ -101,8 +101,9 @@ func CORS(config CORSConfig) func(http.Handler) http.Handler {
 				}
 			}
 
-			// Handle preflight request
-			if r.Method == http.MethodOptions {
+			// Handle preflight request. Plain OPTIONS requests (without
+			// Access-Control-Request-Method) fall through to the router.
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
 				w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
 				w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
 				w.Header().Set("Access-Control-Max-Age", maxAge)
@@ -3,6 +3,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -117,6 +118,24 @@ func (rl *RateLimiter) cleanupExpired() {
 	}
 }
 
+// SetLimits changes the rate and window at runtime (e.g. on config reload).
+// Clients keep their current tokens until their window resets.
+func (rl *RateLimiter) SetLimits(rate int, window time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.rate = rate
+	rl.window = window
+}
+
+// Limit returns the current number of requests allowed per window
+func (rl *RateLimiter) Limit() int {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	return rl.rate
+}
+
 // Stop stops the cleanup goroutine. Call this when shutting down.
 func (rl *RateLimiter) Stop() {
 	close(rl.stopChan)
@@ -158,9 +177,12 @@ func (rl *RateLimiter) Allow(key string) bool {
 
 // RateLimit returns a middleware that limits requests based on client IP.
 func RateLimit(config RateLimitConfig) func(http.Handler) http.Handler {
-	limiter := NewRateLimiter(config)
+	return RateLimitWith(NewRateLimiter(config), config.KeyFunc)
+}
 
-	keyFunc := config.KeyFunc
+// RateLimitWith returns a middleware that limits requests using an existing limiter,
+// so its limits can be changed at runtime with SetLimits. A nil keyFunc uses the client IP.
+func RateLimitWith(limiter *RateLimiter, keyFunc func(r *http.Request) string) func(http.Handler) http.Handler {
 	if keyFunc == nil {
 		keyFunc = defaultKeyFunc
 	}
@@ -172,7 +194,7 @@ func RateLimit(config RateLimitConfig) func(http.Handler) http.Handler {
 			if !limiter.Allow(key) {
 				// Set Retry-After header
 				w.Header().Set("Retry-After", "60")
-				w.Header().Set("X-RateLimit-Limit", string(rune(config.Rate)))
+				w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limiter.Limit()))
 
 				response.Error(w, http.StatusTooManyRequests, "Rate limit exceeded. Please try again later.")
 				return
@@ -0,0 +1,177 @@
+// Package pagination parses and validates list query parameters (limit, offset,
+// sort, order) and builds the pagination metadata returned with list responses.
+//
+// Usage in a handler:
+//
+//	params, err := pagination.Parse(r.URL.Query(), pagination.Options{
+//		SortFields:  []string{"created_at", "name"},
+//		DefaultSort: "created_at",
+//	})
+//	if err != nil {
+//		response.BadRequest(w, pagination.Fields(err))
+//		return
+//	}
+//
+// Repositories fetch params.FetchLimit() rows and Page trims the extra row
+// to report whether more results exist.
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Default and maximum page sizes shared by all list endpoints
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Sort orders
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Params holds validated pagination parameters
+type Params struct {
+	Sort   string
+	Order  string
+	Limit  int
+	Offset int
+}
+
+// Options configures which parameters an endpoint accepts
+type Options struct {
+	// SortFields is the allow-list of sortable fields. Empty disables the sort parameter.
+	SortFields []string
+
+	// DefaultSort is used when no sort parameter is given
+	DefaultSort string
+
+	// DefaultOrder is used when no order parameter is given (default: desc)
+	DefaultOrder string
+}
+
+// Meta describes the returned page
+type Meta struct {
+	Sort    string `json:"sort,omitempty" example:"created_at"`
+	Order   string `json:"order,omitempty" example:"desc"`
+	Limit   int    `json:"limit" example:"20"`
+	Offset  int    `json:"offset" example:"0"`
+	HasMore bool   `json:"has_more" example:"true"`
+}
+
+// ValidationError lists invalid parameters by name, ready for a JSend fail response
+type ValidationError struct {
+	Fields map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid pagination parameters"
+}
+
+// Fields returns the invalid parameters of a ValidationError, or nil for other errors
+func Fields(err error) map[string]string {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Fields
+	}
+	return nil
+}
+
+// Parse validates the limit, offset, sort and order query parameters.
+// Missing parameters use the defaults; invalid ones return a *ValidationError.
+func Parse(query url.Values, opts Options) (Params, error) {
+	params := Params{
+		Limit: DefaultLimit,
+		Sort:  opts.DefaultSort,
+		Order: opts.DefaultOrder,
+	}
+	if params.Order == "" {
+		params.Order = OrderDesc
+	}
+
+	fields := map[string]string{}
+	parseLimitOffset(query, &params, fields)
+	parseSortOrder(query, opts, &params, fields)
+
+	if len(fields) > 0 {
+		return Params{}, &ValidationError{Fields: fields}
+	}
+
+	return params, nil
+}
+
+// parseLimitOffset reads the limit and offset parameters into params,
+// recording invalid values in fields
+func parseLimitOffset(query url.Values, params *Params, fields map[string]string) {
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			fields["limit"] = "Limit must be an integer between 1 and " + strconv.Itoa(MaxLimit)
+		}
+		params.Limit = limit
+	}
+
+	if v := query.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			fields["offset"] = "Offset must be a non-negative integer"
+		}
+		params.Offset = offset
+	}
+}
+
+// parseSortOrder reads the sort and order parameters into params,
+// recording invalid values in fields
+func parseSortOrder(query url.Values, opts Options, params *Params, fields map[string]string) {
+	if v := query.Get("sort"); v != "" {
+		if !slices.Contains(opts.SortFields, v) {
+			if len(opts.SortFields) == 0 {
+				fields["sort"] = "Sorting is not supported"
+			} else {
+				fields["sort"] = "Sort must be one of: " + strings.Join(opts.SortFields, ", ")
+			}
+		}
+		params.Sort = v
+	}
+
+	if v := query.Get("order"); v != "" {
+		v = strings.ToLower(v)
+		if v != OrderAsc && v != OrderDesc {
+			fields["order"] = "Order must be asc or desc"
+		}
+		params.Order = v
+	}
+}
+
+// FetchLimit is the number of rows to query: one more than the page size,
+// so Page can tell whether there are more results
+func (p Params) FetchLimit() int {
+	return p.Limit + 1
+}
+
+// Page trims items fetched with FetchLimit to the page size and builds the metadata.
+// It never returns a nil slice, so empty pages encode as [].
+func Page[T any](items []T, p Params) ([]T, Meta) {
+	meta := Meta{
+		Sort:   p.Sort,
+		Order:  p.Order,
+		Limit:  p.Limit,
+		Offset: p.Offset,
+	}
+
+	if len(items) > p.Limit {
+		items = items[:p.Limit]
+		meta.HasMore = true
+	}
+	if items == nil {
+		items = []T{}
+	}
+
+	return items, meta
+}
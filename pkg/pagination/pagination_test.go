@@ -0,0 +1,96 @@
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+var testOptions = Options{
+	SortFields:  []string{"created_at", "name"},
+	DefaultSort: "created_at",
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		want       Params
+		wantFields []string
+	}{
+		{name: "defaults", query: "", want: Params{Limit: DefaultLimit, Sort: "created_at", Order: OrderDesc}},
+		{name: "all params", query: "limit=50&offset=10&sort=name&order=ASC", want: Params{Limit: 50, Offset: 10, Sort: "name", Order: OrderAsc}},
+		{name: "max limit", query: "limit=100", want: Params{Limit: MaxLimit, Sort: "created_at", Order: OrderDesc}},
+		{name: "limit too large", query: "limit=101", wantFields: []string{"limit"}},
+		{name: "zero limit", query: "limit=0", wantFields: []string{"limit"}},
+		{name: "non-numeric limit", query: "limit=ten", wantFields: []string{"limit"}},
+		{name: "negative offset", query: "offset=-1", wantFields: []string{"offset"}},
+		{name: "sort not allowed", query: "sort=password_hash", wantFields: []string{"sort"}},
+		{name: "invalid order", query: "order=sideways", wantFields: []string{"order"}},
+		{name: "several invalid", query: "limit=-5&offset=x&sort=id", wantFields: []string{"limit", "offset", "sort"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Parse(query, testOptions)
+
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Parse() error = %v, want *ValidationError", err)
+			}
+			fields := Fields(err)
+			if len(fields) != len(tt.wantFields) {
+				t.Errorf("Parse() invalid fields = %v, want %v", fields, tt.wantFields)
+			}
+			for _, field := range tt.wantFields {
+				if fields[field] == "" {
+					t.Errorf("expected error for %q, got %v", field, fields)
+				}
+			}
+		})
+	}
+}
+
+func TestParse_SortingDisabled(t *testing.T) {
+	_, err := Parse(url.Values{"sort": {"name"}}, Options{})
+	if Fields(err)["sort"] == "" {
+		t.Errorf("expected sort error when no sort fields are allowed, got %v", err)
+	}
+}
+
+func TestPage(t *testing.T) {
+	p := Params{Limit: 2, Offset: 4, Sort: "created_at", Order: OrderDesc}
+
+	items, meta := Page([]int{1, 2, 3}, p)
+	if len(items) != 2 || !meta.HasMore {
+		t.Errorf("Page() with extra row = %v, %+v; want 2 items and has_more", items, meta)
+	}
+	if meta.Limit != 2 || meta.Offset != 4 || meta.Sort != "created_at" || meta.Order != OrderDesc {
+		t.Errorf("Page() meta = %+v", meta)
+	}
+
+	items, meta = Page([]int{1, 2}, p)
+	if len(items) != 2 || meta.HasMore {
+		t.Errorf("Page() with full page = %v, %+v; want 2 items and no more", items, meta)
+	}
+
+	items, _ = Page[int](nil, p)
+	if items == nil || len(items) != 0 {
+		t.Errorf("Page(nil) = %#v, want empty non-nil slice", items)
+	}
+}
@@ -19,6 +19,7 @@ const (
 type Response struct {
 	Status  string `json:"status"`
 	Data    any    `json:"data,omitempty"`
+	Meta    any    `json:"meta,omitempty"`
 	Message string `json:"message,omitempty"`
 	Code    int    `json:"code,omitempty"`
 }
@@ -41,6 +42,19 @@ func SuccessWithStatus(w http.ResponseWriter, statusCode int, data any) {
 	writeJSON(w, statusCode, resp)
 }
 
+// SuccessWithMeta sends a JSend success response with status 200 OK and a top-level
+// meta object, e.g. pagination details for list endpoints.
+//
+// Example output: {"status": "success", "data": [...], "meta": {"limit": 20, "offset": 0}}
+func SuccessWithMeta(w http.ResponseWriter, data, meta any) {
+	resp := Response{
+		Status: StatusSuccess,
+		Data:   data,
+		Meta:   meta,
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
 // Created sends a JSend success response with status 201 Created.
 // Use this when a new resource has been successfully created.
 func Created(w http.ResponseWriter, data any) {
@@ -90,6 +104,13 @@ func Forbidden(w http.ResponseWriter, data any) {
 	Fail(w, http.StatusForbidden, data)
 }
 
+// MethodNotAllowed sends a JSend fail response with status 405 Method Not Allowed.
+// Use this when the resource exists but does not support the request method.
+// Callers should set the Allow header before calling this.
+func MethodNotAllowed(w http.ResponseWriter, data any) {
+	Fail(w, http.StatusMethodNotAllowed, data)
+}
+
 // Conflict sends a JSend fail response with status 409 Conflict.
 // Use this when there's a conflict with the current state (e.g., duplicate email).
 func Conflict(w http.ResponseWriter, data any) {
@@ -0,0 +1,99 @@
+// Package router provides environment-aware route registration on top of http.ServeMux.
+// Development-only routes (examples, test pages) are simply not mounted when disabled,
+// so they return 404 in production instead of relying on per-handler checks.
+//
+// The Router is also an http.Handler that answers unmatched requests in JSend format:
+// 405 with an Allow header when the path exists for other methods, automatic OPTIONS
+// responses built from the registered methods, and 404 otherwise. HEAD requests are
+// served by GET routes (standard ServeMux behavior).
+package router
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"go-api-template/pkg/response"
+)
+
+// probeMethods are the methods checked when building the Allow header for a path
+var probeMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+}
+
+// Router registers routes on a ServeMux, skipping development-only routes when disabled
+type Router struct {
+	mux              *http.ServeMux
+	devRoutesEnabled bool
+}
+
+// New creates a router that registers routes on mux.
+// devRoutesEnabled controls whether routes registered with HandleDevFunc are mounted.
+func New(mux *http.ServeMux, devRoutesEnabled bool) *Router {
+	return &Router{
+		mux:              mux,
+		devRoutesEnabled: devRoutesEnabled,
+	}
+}
+
+// HandleFunc registers a route in every environment
+func (r *Router) HandleFunc(pattern string, handler http.HandlerFunc) {
+	r.mux.HandleFunc(pattern, handler)
+}
+
+// HandleDevFunc registers a development-only route.
+// The route is not mounted at all when development routes are disabled.
+func (r *Router) HandleDevFunc(pattern string, handler http.HandlerFunc) {
+	if !r.devRoutesEnabled {
+		slog.Debug("development route not mounted", slog.String("pattern", pattern))
+		return
+	}
+	r.mux.HandleFunc(pattern, handler)
+}
+
+// Mux returns the underlying ServeMux for feature packages that register their own routes
+func (r *Router) Mux() *http.ServeMux {
+	return r.mux
+}
+
+// ServeHTTP dispatches the request to the matching route, or answers with
+// 204 (OPTIONS), 405 (wrong method) or 404 (unknown path) in JSend format.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if _, pattern := r.mux.Handler(req); pattern != "" {
+		r.mux.ServeHTTP(w, req)
+		return
+	}
+
+	allowed := r.allowedMethods(req)
+	if len(allowed) == 0 {
+		response.NotFound(w, map[string]string{"path": "Resource not found"})
+		return
+	}
+
+	w.Header().Set("Allow", strings.Join(append(allowed, http.MethodOptions), ", "))
+
+	if req.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	response.MethodNotAllowed(w, map[string]string{"method": "Method " + req.Method + " not allowed"})
+}
+
+// allowedMethods returns the methods that have a route registered for the request path
+func (r *Router) allowedMethods(req *http.Request) []string {
+	var allowed []string
+	for _, method := range probeMethods {
+		probe := req.WithContext(req.Context())
+		probe.Method = method
+		if _, pattern := r.mux.Handler(probe); pattern != "" {
+			allowed = append(allowed, method)
+		}
+	}
+	return allowed
+}
@@ -0,0 +1,91 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestRouter(devRoutesEnabled bool) *Router {
+	rt := New(http.NewServeMux(), devRoutesEnabled)
+	ok := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) }
+
+	rt.HandleFunc("GET /users/{id}", ok)
+	rt.HandleFunc("DELETE /users/{id}", ok)
+	rt.HandleDevFunc("GET /test/hello", ok)
+
+	return rt
+}
+
+func decodeStatus(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body map[string]any
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode JSend body: %v", err)
+	}
+	status, _ := body["status"].(string) //nolint:errcheck // checked by caller
+	return status
+}
+
+func TestRouter_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantCode  int
+		wantAllow string
+		wantJSend bool
+	}{
+		{name: "matched route", method: http.MethodGet, path: "/users/1", wantCode: http.StatusOK},
+		{name: "HEAD served by GET route", method: http.MethodHead, path: "/users/1", wantCode: http.StatusOK},
+		{name: "wrong method", method: http.MethodPost, path: "/users/1", wantCode: http.StatusMethodNotAllowed, wantAllow: "GET, HEAD, DELETE, OPTIONS", wantJSend: true},
+		{name: "OPTIONS lists methods", method: http.MethodOptions, path: "/users/1", wantCode: http.StatusNoContent, wantAllow: "GET, HEAD, DELETE, OPTIONS"},
+		{name: "unknown path", method: http.MethodGet, path: "/orders", wantCode: http.StatusNotFound, wantJSend: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newTestRouter(true)
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			rt.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("expected status %d, got %d", tt.wantCode, w.Code)
+			}
+			if got := w.Header().Get("Allow"); got != tt.wantAllow {
+				t.Errorf("expected Allow %q, got %q", tt.wantAllow, got)
+			}
+			if tt.wantJSend && decodeStatus(t, w) != "fail" {
+				t.Errorf("expected JSend fail body")
+			}
+		})
+	}
+}
+
+func TestRouter_HandleDevFunc(t *testing.T) {
+	tests := []struct {
+		name             string
+		devRoutesEnabled bool
+		wantCode         int
+	}{
+		{name: "mounted when enabled", devRoutesEnabled: true, wantCode: http.StatusOK},
+		{name: "not mounted when disabled", devRoutesEnabled: false, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newTestRouter(tt.devRoutesEnabled)
+			req := httptest.NewRequest(http.MethodGet, "/test/hello", nil)
+			w := httptest.NewRecorder()
+
+			rt.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("expected status %d, got %d", tt.wantCode, w.Code)
+			}
+		})
+	}
+}